@@ -0,0 +1,106 @@
+package maps
+
+import "fmt"
+
+// BiMap is a one-to-one map which can be looked up in both directions.
+// Both directions are kept in sync on every insertion and deletion.
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewBiMap creates an empty BiMap
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: map[K]V{},
+		inverse: map[V]K{},
+	}
+}
+
+// NewBiMapFrom creates a BiMap holding the entries of m.
+// It returns an error wrapping ErrConflict if several keys share the same value.
+func NewBiMapFrom[K, V comparable](m map[K]V) (*BiMap[K, V], error) {
+	inverse, err := Invert(m)
+	if err != nil {
+		return nil, err
+	}
+	forward := make(map[K]V, len(m))
+	for k, v := range m {
+		forward[k] = v
+	}
+	return &BiMap[K, V]{forward: forward, inverse: inverse}, nil
+}
+
+// Put associates key with value. If key already holds another value, that value is released.
+// It returns an error wrapping ErrConflict if value is already held by another key.
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if other, ok := b.inverse[value]; ok && other != key {
+		return fmt.Errorf("%w: value %v is already held by key %v", ErrConflict, value, other)
+	}
+	b.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut associates key with value, removing any entry which held either of them
+func (b *BiMap[K, V]) ForcePut(key K, value V) {
+	b.DeleteKey(key)
+	b.DeleteValue(value)
+	b.forward[key] = value
+	b.inverse[value] = key
+}
+
+// Get returns the value held by key
+func (b *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := b.forward[key]
+	return v, ok
+}
+
+// GetKey returns the key holding value
+func (b *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := b.inverse[value]
+	return k, ok
+}
+
+// DeleteKey removes the entry of key. It reports whether the entry existed.
+func (b *BiMap[K, V]) DeleteKey(key K) bool {
+	v, ok := b.forward[key]
+	if !ok {
+		return false
+	}
+	delete(b.forward, key)
+	delete(b.inverse, v)
+	return true
+}
+
+// DeleteValue removes the entry of value. It reports whether the entry existed.
+func (b *BiMap[K, V]) DeleteValue(value V) bool {
+	k, ok := b.inverse[value]
+	if !ok {
+		return false
+	}
+	delete(b.inverse, value)
+	delete(b.forward, k)
+	return true
+}
+
+// Len returns the number of entries
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// Inverse returns a view of b with keys and values swapped. Changes to either are visible in both.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{
+		forward: b.inverse,
+		inverse: b.forward,
+	}
+}
+
+// Map returns a copy of the entries as a plain map
+func (b *BiMap[K, V]) Map() map[K]V {
+	result := make(map[K]V, len(b.forward))
+	for k, v := range b.forward {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,366 @@
+package maps
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+type (
+	// ConcurrentMap is a map which is safe for concurrent use.
+	// Keys are spread over shards, each guarded by its own lock, so writers to different shards don't block each other.
+	ConcurrentMap[K comparable, V any] struct {
+		shards []*shard[K, V]
+		hasher func(key K) uint64
+	}
+
+	// ConcurrentMapOptions configures NewConcurrentMap. The zero value uses the defaults.
+	ConcurrentMapOptions[K comparable] struct {
+		// Shards is the number of shards. Defaults to four times runtime.GOMAXPROCS(0).
+		Shards int
+		// Hasher spreads keys over the shards. Equal keys must have equal hashes.
+		// Defaults to a hasher which handles the basic types natively and hashes other keys with reflection:
+		// pointers and channels by address, arrays and structs field by field (skipping blank fields)
+		// and interfaces by their dynamic value. Provide a Hasher for structs, arrays or interfaces on hot paths,
+		// as the reflection-based hashing allocates.
+		Hasher func(key K) uint64
+	}
+
+	shard[K comparable, V any] struct {
+		sync.RWMutex
+		m map[K]V
+	}
+)
+
+// NewConcurrentMap creates an empty ConcurrentMap
+func NewConcurrentMap[K comparable, V any](opts ConcurrentMapOptions[K]) *ConcurrentMap[K, V] {
+	n := opts.Shards
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0) * 4
+	}
+	hasher := opts.Hasher
+	if hasher == nil {
+		hasher = defaultHasher[K](maphash.MakeSeed())
+	}
+	m := &ConcurrentMap[K, V]{
+		shards: make([]*shard[K, V], n),
+		hasher: hasher,
+	}
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{m: map[K]V{}}
+	}
+	return m
+}
+
+// Load returns the value of key
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shardOf(key)
+	s.RLock()
+	defer s.RUnlock()
+	v, ok := s.m[key]
+	return v, ok
+}
+
+// Store sets the value of key
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	s.m[key] = value
+}
+
+// LoadOrStore returns the existing value of key if present. Otherwise, it stores and returns value.
+// loaded is true if the value was loaded.
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := m.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+	s.m[key] = value
+	return value, false
+}
+
+// LoadAndDelete removes key and returns its previous value
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	v, ok := s.m[key]
+	delete(s.m, key)
+	return v, ok
+}
+
+// Delete removes key
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	m.LoadAndDelete(key)
+}
+
+// Compute atomically updates the value of key. f receives the current value and whether it exists,
+// and returns the new value and whether to keep it; if keep is false, the key is removed.
+// f must not call other methods of m, as the shard of key is locked while it runs.
+func (m *ConcurrentMap[K, V]) Compute(key K, f func(value V, ok bool) (newValue V, keep bool)) (V, bool) {
+	s := m.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	old, ok := s.m[key]
+	v, keep := f(old, ok)
+	if !keep {
+		delete(s.m, key)
+		var zero V
+		return zero, false
+	}
+	s.m[key] = v
+	return v, true
+}
+
+// Len returns the number of entries
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.shards {
+		s.RLock()
+		n += len(s.m)
+		s.RUnlock()
+	}
+	return n
+}
+
+// Snapshot returns a consistent copy of all entries as a plain map
+func (m *ConcurrentMap[K, V]) Snapshot() map[K]V {
+	for _, s := range m.shards {
+		s.RLock()
+	}
+	n := 0
+	for _, s := range m.shards {
+		n += len(s.m)
+	}
+	result := make(map[K]V, n)
+	for _, s := range m.shards {
+		for k, v := range s.m {
+			result[k] = v
+		}
+		s.RUnlock()
+	}
+	return result
+}
+
+// Range calls f for each entry of a snapshot until f returns false.
+// f runs without holding any lock, so it may call other methods of m.
+func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
+	for k, v := range m.Snapshot() {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// StoreAll sets all entries of src atomically: other goroutines observe either none or all of them
+func (m *ConcurrentMap[K, V]) StoreAll(src map[K]V) {
+	groups := make(map[int][]K, len(m.shards))
+	for k := range src {
+		i := m.shardIndex(k)
+		groups[i] = append(groups[i], k)
+	}
+	unlock := m.lockShards(groups)
+	defer unlock()
+	for i, ks := range groups {
+		for _, k := range ks {
+			m.shards[i].m[k] = src[k]
+		}
+	}
+}
+
+// DeleteAll removes all given keys atomically
+func (m *ConcurrentMap[K, V]) DeleteAll(keys ...K) {
+	groups := make(map[int][]K, len(m.shards))
+	for _, k := range keys {
+		i := m.shardIndex(k)
+		groups[i] = append(groups[i], k)
+	}
+	unlock := m.lockShards(groups)
+	defer unlock()
+	for i, ks := range groups {
+		for _, k := range ks {
+			delete(m.shards[i].m, k)
+		}
+	}
+}
+
+// Clear removes all entries atomically
+func (m *ConcurrentMap[K, V]) Clear() {
+	for _, s := range m.shards {
+		s.Lock()
+	}
+	for _, s := range m.shards {
+		s.m = map[K]V{}
+		s.Unlock()
+	}
+}
+
+func (m *ConcurrentMap[K, V]) shardIndex(key K) int {
+	return int(m.hasher(key) % uint64(len(m.shards)))
+}
+
+func (m *ConcurrentMap[K, V]) shardOf(key K) *shard[K, V] {
+	return m.shards[m.shardIndex(key)]
+}
+
+// lockShards locks the shards in ascending order, so concurrent bulk operations can't deadlock
+func (m *ConcurrentMap[K, V]) lockShards(groups map[int][]K) (unlock func()) {
+	locked := []*shard[K, V]{}
+	for i, s := range m.shards {
+		if _, ok := groups[i]; ok {
+			s.Lock()
+			locked = append(locked, s)
+		}
+	}
+	return func() {
+		for _, s := range locked {
+			s.Unlock()
+		}
+	}
+}
+
+// defaultHasher creates the hasher for K. How keys are hashed is decided once, from the type of K.
+func defaultHasher[K comparable](seed maphash.Seed) func(key K) uint64 {
+	var zero K
+	if _, ok := hashBasic(seed, zero); ok {
+		return func(key K) uint64 {
+			h, _ := hashBasic(seed, key)
+			return h
+		}
+	}
+	hash := valueHasher(seed, reflect.TypeOf(&zero).Elem())
+	return func(key K) uint64 {
+		return hash(reflect.ValueOf(&key).Elem())
+	}
+}
+
+// hashBasic hashes the predeclared types which don't need reflection. ok is false for any other type.
+func hashBasic(seed maphash.Seed, key interface{}) (h uint64, ok bool) {
+	switch k := key.(type) {
+	case string:
+		return maphash.String(seed, k), true
+	case int:
+		return mix(uint64(k)), true
+	case int8:
+		return mix(uint64(k)), true
+	case int16:
+		return mix(uint64(k)), true
+	case int32:
+		return mix(uint64(k)), true
+	case int64:
+		return mix(uint64(k)), true
+	case uint:
+		return mix(uint64(k)), true
+	case uint8:
+		return mix(uint64(k)), true
+	case uint16:
+		return mix(uint64(k)), true
+	case uint32:
+		return mix(uint64(k)), true
+	case uint64:
+		return mix(k), true
+	case uintptr:
+		return mix(uint64(k)), true
+	case float32:
+		return hashFloat(float64(k)), true
+	case float64:
+		return hashFloat(k), true
+	}
+	return 0, false
+}
+
+// valueHasher creates a hasher for values of type t which agrees with ==
+func valueHasher(seed maphash.Seed, t reflect.Type) func(v reflect.Value) uint64 {
+	switch t.Kind() {
+	case reflect.Bool:
+		return func(v reflect.Value) uint64 {
+			if v.Bool() {
+				return mix(1)
+			}
+			return mix(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v reflect.Value) uint64 { return mix(uint64(v.Int())) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(v reflect.Value) uint64 { return mix(v.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(v reflect.Value) uint64 { return hashFloat(v.Float()) }
+	case reflect.Complex64, reflect.Complex128:
+		return func(v reflect.Value) uint64 {
+			c := v.Complex()
+			return combineHash(hashFloat(real(c)), hashFloat(imag(c)))
+		}
+	case reflect.String:
+		return func(v reflect.Value) uint64 { return maphash.String(seed, v.String()) }
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		// pointers are equal if they point to the same address, whatever the pointee holds
+		return func(v reflect.Value) uint64 { return mix(uint64(v.Pointer())) }
+	case reflect.Array:
+		elem := valueHasher(seed, t.Elem())
+		return func(v reflect.Value) uint64 {
+			var h uint64
+			for i := 0; i < v.Len(); i++ {
+				h = combineHash(h, elem(v.Index(i)))
+			}
+			return h
+		}
+	case reflect.Struct:
+		type field struct {
+			index int
+			hash  func(v reflect.Value) uint64
+		}
+		fields := []field{}
+		for i := 0; i < t.NumField(); i++ {
+			// blank fields are ignored by ==
+			if f := t.Field(i); f.Name != "_" {
+				fields = append(fields, field{index: i, hash: valueHasher(seed, f.Type)})
+			}
+		}
+		return func(v reflect.Value) uint64 {
+			var h uint64
+			for _, f := range fields {
+				h = combineHash(h, f.hash(v.Field(f.index)))
+			}
+			return h
+		}
+	case reflect.Interface:
+		return func(v reflect.Value) uint64 {
+			if v.IsNil() {
+				return 0
+			}
+			e := v.Elem()
+			return valueHasher(seed, e.Type())(e)
+		}
+	}
+	// maps, slices and funcs can't be keys, except inside an interface, where == panics as well
+	panic(fmt.Sprintf("maps: %v is not comparable", t))
+}
+
+// combineHash folds h2 into h1, taking the order of the values into account
+func combineHash(h1, h2 uint64) uint64 {
+	return mix(h1*0x9e3779b97f4a7c15 + h2)
+}
+
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		// +0 and -0 are equal keys
+		f = 0
+	}
+	return mix(math.Float64bits(f))
+}
+
+// mix is the finalizer of SplitMix64, which spreads sequential integers over all bits
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
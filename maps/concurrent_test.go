@@ -0,0 +1,186 @@
+package maps
+
+import (
+	"hash/maphash"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := NewConcurrentMap[string, int](ConcurrentMapOptions[string]{})
+
+	m.Store("a", 1)
+	if v, ok := m.Load("a"); !ok || v != 1 {
+		t.Errorf("Load() = %v, %v, want 1, true", v, ok)
+	}
+	if v, loaded := m.LoadOrStore("a", 2); !loaded || v != 1 {
+		t.Errorf("LoadOrStore() = %v, %v, want 1, true", v, loaded)
+	}
+	if v, loaded := m.LoadOrStore("b", 2); loaded || v != 2 {
+		t.Errorf("LoadOrStore() = %v, %v, want 2, false", v, loaded)
+	}
+	if v, ok := m.LoadAndDelete("b"); !ok || v != 2 {
+		t.Errorf("LoadAndDelete() = %v, %v, want 2, true", v, ok)
+	}
+	m.Delete("missing")
+
+	inc := func(v int, _ bool) (int, bool) { return v + 1, true }
+	if v, ok := m.Compute("a", inc); !ok || v != 2 {
+		t.Errorf("Compute() = %v, %v, want 2, true", v, ok)
+	}
+	if v, ok := m.Compute("c", inc); !ok || v != 1 {
+		t.Errorf("Compute() = %v, %v, want 1, true", v, ok)
+	}
+	if _, ok := m.Compute("c", func(int, bool) (int, bool) { return 0, false }); ok || m.Len() != 1 {
+		t.Errorf("Compute() didn't delete the key, Len() = %d", m.Len())
+	}
+
+	m.StoreAll(map[string]int{"x": 10, "y": 20})
+	if diff := cmp.Diff(m.Snapshot(), map[string]int{"a": 2, "x": 10, "y": 20}); diff != "" {
+		t.Errorf("Snapshot() diff %s", diff)
+	}
+	m.DeleteAll("x", "y", "missing")
+	visited := map[string]int{}
+	m.Range(func(k string, v int) bool {
+		// Range runs on a snapshot, so modifying m is allowed
+		m.Store(k+"2", v)
+		visited[k] = v
+		return true
+	})
+	if diff := cmp.Diff(visited, map[string]int{"a": 2}); diff != "" {
+		t.Errorf("Range() diff %s", diff)
+	}
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Len() after Clear() = %d", m.Len())
+	}
+}
+
+func TestConcurrentMapHasher(t *testing.T) {
+	type point struct{ x, y int }
+
+	calls := 0
+	m := NewConcurrentMap[point, string](ConcurrentMapOptions[point]{
+		Shards: 3,
+		Hasher: func(p point) uint64 {
+			calls++
+			return uint64(p.x*31 + p.y)
+		},
+	})
+	m.Store(point{1, 2}, "a")
+	if v, ok := m.Load(point{1, 2}); !ok || v != "a" || calls != 2 {
+		t.Errorf("Load() = %v, %v with %d hasher calls", v, ok, calls)
+	}
+
+	seed := maphash.MakeSeed()
+	zero, negativeZero := 0.0, 0.0
+	negativeZero *= -1
+	if hf := defaultHasher[float64](seed); hf(zero) != hf(negativeZero) {
+		t.Error("default hasher hashes +0 and -0 differently")
+	}
+	if hp := defaultHasher[point](seed); hp(point{1, 2}) != hp(point{1, 2}) {
+		t.Error("default hasher isn't deterministic for structs")
+	}
+
+	type id string
+	if hi := defaultHasher[id](seed); hi("a") != hi(id([]byte{'a'})) {
+		t.Error("default hasher isn't deterministic for named types")
+	}
+	type boxed struct {
+		v interface{}
+		_ int
+	}
+	hb := valueHasher(seed, reflect.TypeOf(boxed{}))
+	if hb(reflect.ValueOf(boxed{v: point{1, 2}})) != hb(reflect.ValueOf(boxed{v: point{1, 2}})) || hb(reflect.ValueOf(boxed{})) != hb(reflect.ValueOf(boxed{})) {
+		t.Error("default hasher isn't deterministic for interface fields")
+	}
+}
+
+func TestConcurrentMapPointerKey(t *testing.T) {
+	type node struct{ n int }
+
+	m := NewConcurrentMap[*node, string](ConcurrentMapOptions[*node]{Shards: 64})
+	k := &node{1}
+	m.Store(k, "a")
+	// pointer keys are compared by address, so mutating the pointee must not move the key to another shard
+	k.n = 2
+	if v, ok := m.Load(k); !ok || v != "a" {
+		t.Errorf("Load() = %v, %v, want a, true", v, ok)
+	}
+	if _, ok := m.Load(&node{2}); ok {
+		t.Error("Load() found a key by the value it points to")
+	}
+}
+
+func TestConcurrentMapStress(t *testing.T) {
+	const (
+		goroutines = 16
+		iterations = 1000
+	)
+	m := NewConcurrentMap[int, int](ConcurrentMapOptions[int]{Shards: 8})
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				m.Compute(i%100, func(v int, _ bool) (int, bool) { return v + 1, true })
+				m.LoadOrStore(g*iterations+i+1000, i)
+				m.Load(i % 100)
+				if i%100 == 0 {
+					m.Len()
+					m.Snapshot()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	total := 0
+	for k := 0; k < 100; k++ {
+		v, _ := m.Load(k)
+		total += v
+	}
+	if want := goroutines * iterations; total != want {
+		t.Errorf("Compute() lost updates: total = %d, want %d", total, want)
+	}
+	if want := 100 + goroutines*iterations; m.Len() != want {
+		t.Errorf("Len() = %d, want %d", m.Len(), want)
+	}
+}
+
+func TestConcurrentMapBulkAtomicity(t *testing.T) {
+	m := NewConcurrentMap[string, int](ConcurrentMapOptions[string]{Shards: 16})
+	batch := map[string]int{}
+	keys := []string{}
+	for i := 0; i < 50; i++ {
+		k := strconv.Itoa(i)
+		batch[k] = i
+		keys = append(keys, k)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			m.StoreAll(batch)
+			m.DeleteAll(keys...)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			if n := len(m.Snapshot()); n != 0 && n != len(batch) {
+				t.Errorf("Snapshot() observed a partial batch of %d entries", n)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,96 @@
+package maps
+
+type (
+	// Change holds the old and the new value of a key
+	Change[V any] struct {
+		Old V
+		New V
+	}
+
+	// Patch describes the differences between two maps. Applying it to the old map turns it into the new one.
+	Patch[K comparable, V any] struct {
+		Added   map[K]V
+		Removed map[K]V
+		Changed map[K]Change[V]
+	}
+)
+
+// Diff compares two versions of a map and reports the keys which were added, removed or changed
+func Diff[K, V comparable](old, new map[K]V) Patch[K, V] {
+	return DiffFunc(old, new, func(a, b V) bool { return a == b })
+}
+
+// DiffFunc works like Diff, but compares values with eq, so it can be used with values which are not comparable
+func DiffFunc[K comparable, V any](old, new map[K]V, eq func(a, b V) bool) Patch[K, V] {
+	p := Patch[K, V]{
+		Added:   map[K]V{},
+		Removed: map[K]V{},
+		Changed: map[K]Change[V]{},
+	}
+	for k, ov := range old {
+		nv, ok := new[k]
+		if !ok {
+			p.Removed[k] = ov
+			continue
+		}
+		if !eq(ov, nv) {
+			p.Changed[k] = Change[V]{Old: ov, New: nv}
+		}
+	}
+	for k, nv := range new {
+		if _, ok := old[k]; !ok {
+			p.Added[k] = nv
+		}
+	}
+	return p
+}
+
+// Equal checks if two maps hold the same entries
+func Equal[K, V comparable](a, b map[K]V) bool {
+	return EqualFunc(a, b, func(x, y V) bool { return x == y })
+}
+
+// EqualFunc checks if two maps hold the same keys with values which eq considers equal
+func EqualFunc[K comparable, V any](a, b map[K]V, eq func(x, y V) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok || !eq(av, bv) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty checks if the patch has no differences
+func (p Patch[K, V]) IsEmpty() bool {
+	return len(p.Added) == 0 && len(p.Removed) == 0 && len(p.Changed) == 0
+}
+
+// Apply applies the patch to m in place
+func (p Patch[K, V]) Apply(m map[K]V) {
+	for k := range p.Removed {
+		delete(m, k)
+	}
+	for k, c := range p.Changed {
+		m[k] = c.New
+	}
+	for k, v := range p.Added {
+		m[k] = v
+	}
+}
+
+// Revert undoes the patch on m in place, turning the new map back into the old one
+func (p Patch[K, V]) Revert(m map[K]V) {
+	for k := range p.Added {
+		delete(m, k)
+	}
+	for k, c := range p.Changed {
+		m[k] = c.Old
+	}
+	for k, v := range p.Removed {
+		m[k] = v
+	}
+}
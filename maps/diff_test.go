@@ -0,0 +1,94 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	old := map[string]string{
+		"www":  "192.0.2.1",
+		"mail": "192.0.2.2",
+		"ftp":  "192.0.2.3",
+	}
+	new := map[string]string{
+		"www":  "192.0.2.1",
+		"mail": "192.0.2.20",
+		"api":  "192.0.2.4",
+	}
+
+	got := Diff(old, new)
+	want := Patch[string, string]{
+		Added:   map[string]string{"api": "192.0.2.4"},
+		Removed: map[string]string{"ftp": "192.0.2.3"},
+		Changed: map[string]Change[string]{
+			"mail": {Old: "192.0.2.2", New: "192.0.2.20"},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Diff() diff %s", diff)
+	}
+
+	m := map[string]string{}
+	for k, v := range old {
+		m[k] = v
+	}
+	got.Apply(m)
+	if diff := cmp.Diff(m, new); diff != "" {
+		t.Errorf("Apply() didn't turn old into new, diff %s", diff)
+	}
+	got.Revert(m)
+	if diff := cmp.Diff(m, old); diff != "" {
+		t.Errorf("Revert() didn't turn new into old, diff %s", diff)
+	}
+
+	if !Diff(old, old).IsEmpty() {
+		t.Error("Diff() of equal maps isn't empty")
+	}
+	if got := Diff(nil, new); len(got.Added) != 3 || len(got.Removed) != 0 {
+		t.Errorf("Diff() from nil = %v", got)
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	old := map[string][]string{
+		"admin": {"read", "write"},
+		"guest": {"read"},
+	}
+	new := map[string][]string{
+		"admin": {"read", "write"},
+		"guest": {},
+	}
+
+	got := DiffFunc(old, new, func(a, b []string) bool { return reflect.DeepEqual(a, b) })
+	if len(got.Changed) != 1 || len(got.Added) != 0 || len(got.Removed) != 0 {
+		t.Errorf("DiffFunc() = %v, want guest changed", got)
+	}
+	if _, ok := got.Changed["guest"]; !ok {
+		t.Errorf("DiffFunc() = %v, want guest changed", got)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    map[string]int
+		b    map[string]int
+		want bool
+	}{
+		{name: "equal", a: map[string]int{"a": 1}, b: map[string]int{"a": 1}, want: true},
+		{name: "different_value", a: map[string]int{"a": 1}, b: map[string]int{"a": 2}, want: false},
+		{name: "different_key", a: map[string]int{"a": 1}, b: map[string]int{"b": 1}, want: false},
+		{name: "different_length", a: map[string]int{"a": 1}, b: map[string]int{}, want: false},
+		{name: "nil_and_empty", a: nil, b: map[string]int{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
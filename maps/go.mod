@@ -3,7 +3,13 @@ module github.com/usk81/toolkit/maps
 go 1.19
 
 require (
+	github.com/google/go-cmp v0.5.9
+	github.com/usk81/toolkit/iterator v0.0.1
 	github.com/usk81/toolkit/testkit v0.0.1
+	golang.org/x/exp v0.0.0-20221126150942-6ab00d035af9
 )
 
-replace github.com/usk81/toolkit/testkit => ../testkit
\ No newline at end of file
+replace (
+	github.com/usk81/toolkit/iterator => ../iterator
+	github.com/usk81/toolkit/testkit => ../testkit
+)
@@ -0,0 +1,25 @@
+package maps
+
+import "fmt"
+
+// Invert creates a map with keys and values swapped.
+// It returns an error wrapping ErrConflict if several keys share the same value.
+func Invert[K, V comparable](m map[K]V) (map[V]K, error) {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		if other, ok := result[v]; ok {
+			return nil, fmt.Errorf("%w: value %v is shared by keys %v and %v", ErrConflict, v, other, k)
+		}
+		result[v] = k
+	}
+	return result, nil
+}
+
+// InvertMulti creates a map from each value to all keys holding it. The keys are in no particular order.
+func InvertMulti[K, V comparable](m map[K]V) map[V][]K {
+	result := map[V][]K{}
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+	return result
+}
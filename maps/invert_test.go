@@ -0,0 +1,112 @@
+package maps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestInvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       map[string]int
+		want    map[int]string
+		wantErr error
+	}{
+		{
+			name: "one_to_one",
+			m:    map[string]int{"one": 1, "two": 2},
+			want: map[int]string{1: "one", 2: "two"},
+		},
+		{
+			name:    "collision",
+			m:       map[string]int{"one": 1, "uno": 1},
+			wantErr: ErrConflict,
+		},
+		{
+			name: "nil",
+			m:    nil,
+			want: map[int]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Invert(tt.m)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Invert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Invert() diff %s", diff)
+			}
+		})
+	}
+}
+
+func TestInvertMulti(t *testing.T) {
+	got := InvertMulti(map[string]int{"one": 1, "uno": 1, "two": 2})
+	want := map[int][]string{
+		1: {"one", "uno"},
+		2: {"two"},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.SortSlices(less[string])); diff != "" {
+		t.Errorf("InvertMulti() diff %s", diff)
+	}
+}
+
+func TestBiMap(t *testing.T) {
+	b := NewBiMap[string, int]()
+	if err := b.Put("one", 1); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := b.Put("two", 2); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := b.Put("uno", 1); !errors.Is(err, ErrConflict) {
+		t.Errorf("Put() error = %v, want %v", err, ErrConflict)
+	}
+
+	// re-putting a key releases its old value
+	if err := b.Put("two", 3); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if _, ok := b.GetKey(2); ok {
+		t.Error("GetKey(2) found a released value")
+	}
+	if k, ok := b.GetKey(3); !ok || k != "two" {
+		t.Errorf("GetKey(3) = %v, %v, want two, true", k, ok)
+	}
+
+	b.ForcePut("uno", 1)
+	if _, ok := b.Get("one"); ok {
+		t.Error("ForcePut() kept the key which held the value")
+	}
+	if v, ok := b.Get("uno"); !ok || v != 1 {
+		t.Errorf("Get(uno) = %v, %v, want 1, true", v, ok)
+	}
+
+	inv := b.Inverse()
+	if k, ok := inv.Get(3); !ok || k != "two" {
+		t.Errorf("Inverse().Get(3) = %v, %v, want two, true", k, ok)
+	}
+	inv.DeleteKey(3)
+	if _, ok := b.Get("two"); ok {
+		t.Error("deleting through Inverse() didn't update the BiMap")
+	}
+
+	if diff := cmp.Diff(b.Map(), map[string]int{"uno": 1}); diff != "" {
+		t.Errorf("Map() diff %s", diff)
+	}
+	if b.Len() != 1 || inv.Len() != 1 {
+		t.Errorf("Len() = %d, %d, want 1", b.Len(), inv.Len())
+	}
+	if !b.DeleteValue(1) || b.DeleteValue(1) {
+		t.Error("DeleteValue() didn't report the deletion correctly")
+	}
+
+	if _, err := NewBiMapFrom(map[string]int{"a": 1, "b": 1}); !errors.Is(err, ErrConflict) {
+		t.Errorf("NewBiMapFrom() error = %v, want %v", err, ErrConflict)
+	}
+}
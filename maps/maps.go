@@ -1,11 +1,30 @@
 package maps
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Entry is a key/value pair of a map
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+var (
+	// ErrLengthMismatch is returned when the slices of keys and values don't have the same number of elements
+	ErrLengthMismatch = errors.New("length mismatch")
+	// ErrDuplicateKey is returned when a key appears more than once where keys must be unique
+	ErrDuplicateKey = errors.New("duplicate key")
+)
 
 // Combine creates an slice by using one slice for keys and another for its values
 func Combine[K comparable, V any](keys []K, values []V) (map[K]V, error) {
-	if len(keys) != len(values) {
-		return nil, errors.New("argument #1 (keys) and argument #2 (values) must have the same number of elements")
+	if err := checkLength(keys, values); err != nil {
+		return nil, err
 	}
 	result := map[K]V{}
 	for i := range keys {
@@ -14,6 +33,61 @@ func Combine[K comparable, V any](keys []K, values []V) (map[K]V, error) {
 	return result, nil
 }
 
+// CombineBy creates a map from a slice by extracting a key and a value from each element.
+// Later elements overwrite earlier ones with the same key.
+func CombineBy[T any, K comparable, V any](xs []T, keyFn func(x T) K, valFn func(x T) V) map[K]V {
+	result := make(map[K]V, len(xs))
+	for _, x := range xs {
+		result[keyFn(x)] = valFn(x)
+	}
+	return result
+}
+
+// CombineStrict works like Combine, but returns an error wrapping ErrDuplicateKey if a key appears more than once
+func CombineStrict[K comparable, V any](keys []K, values []V) (map[K]V, error) {
+	if err := checkLength(keys, values); err != nil {
+		return nil, err
+	}
+	result := make(map[K]V, len(keys))
+	for i, k := range keys {
+		if _, ok := result[k]; ok {
+			return nil, fmt.Errorf("%w: %v at index %d", ErrDuplicateKey, k, i)
+		}
+		result[k] = values[i]
+	}
+	return result, nil
+}
+
+// CombineWith works like Combine, but aggregates the values of duplicate keys with merge
+func CombineWith[K comparable, V any](keys []K, values []V, merge func(key K, a, b V) V) (map[K]V, error) {
+	if err := checkLength(keys, values); err != nil {
+		return nil, err
+	}
+	result := make(map[K]V, len(keys))
+	for i, k := range keys {
+		v := values[i]
+		if existing, ok := result[k]; ok {
+			v = merge(k, existing, v)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// Entries returns the list of all key/value pairs of the given map in no particular order
+func Entries[K comparable, V any](m map[K]V) []Entry[K, V] {
+	if len(m) == 0 {
+		return nil
+	}
+	es := make([]Entry[K, V], len(m))
+	i := 0
+	for k, v := range m {
+		es[i] = Entry[K, V]{Key: k, Value: v}
+		i++
+	}
+	return es
+}
+
 // Keys returns the list of all keys of the given map
 func Keys[K comparable, V any](m map[K]V) []K {
 	if len(m) == 0 {
@@ -27,3 +101,45 @@ func Keys[K comparable, V any](m map[K]V) []K {
 	}
 	return ks
 }
+
+// SortedEntries returns the list of all key/value pairs of the given map in ascending order of keys
+func SortedEntries[K constraints.Ordered, V any](m map[K]V) []Entry[K, V] {
+	es := Entries(m)
+	slices.SortFunc(es, func(a, b Entry[K, V]) bool { return a.Key < b.Key })
+	return es
+}
+
+// SortedKeys returns the list of all keys of the given map in ascending order
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
+	ks := Keys(m)
+	slices.Sort(ks)
+	return ks
+}
+
+// SortedKeysBy returns the list of all keys of the given map sorted by less
+func SortedKeysBy[K comparable, V any](m map[K]V, less func(a, b K) bool) []K {
+	ks := Keys(m)
+	slices.SortFunc(ks, less)
+	return ks
+}
+
+// Values returns the list of all values of the given map in no particular order
+func Values[K comparable, V any](m map[K]V) []V {
+	if len(m) == 0 {
+		return nil
+	}
+	vs := make([]V, len(m))
+	i := 0
+	for _, v := range m {
+		vs[i] = v
+		i++
+	}
+	return vs
+}
+
+func checkLength[K comparable, V any](keys []K, values []V) error {
+	if len(keys) != len(values) {
+		return fmt.Errorf("%w: argument #1 (keys) and argument #2 (values) must have the same number of elements", ErrLengthMismatch)
+	}
+	return nil
+}
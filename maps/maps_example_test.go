@@ -1,6 +1,7 @@
 package maps
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 )
@@ -27,3 +28,50 @@ func ExampleKeys() {
 	fmt.Println(keys)
 	// Output: [bar fizz foo]
 }
+
+func ExampleSortedKeys() {
+	vs := map[string]int{
+		"foo":  123,
+		"bar":  456,
+		"fizz": 789,
+	}
+
+	fmt.Println(SortedKeys(vs))
+	// Output: [bar fizz foo]
+}
+
+func ExampleSortedEntries() {
+	vs := map[string]int{
+		"foo":  123,
+		"bar":  456,
+		"fizz": 789,
+	}
+
+	for _, e := range SortedEntries(vs) {
+		fmt.Println(e.Key, e.Value)
+	}
+	// Output:
+	// bar 456
+	// fizz 789
+	// foo 123
+}
+
+func ExampleMerge() {
+	defaults := map[string]string{"host": "localhost", "port": "8080"}
+	overrides := map[string]string{"port": "9090"}
+	result, _ := Merge(LastWins, defaults, overrides)
+
+	fmt.Println(result)
+	// Output: map[host:localhost port:9090]
+}
+
+func ExampleOrderedMap() {
+	m := NewOrderedMap[string, int]()
+	m.Set("zebra", 1)
+	m.Set("apple", 2)
+	m.Set("mango", 3)
+
+	b, _ := json.Marshal(m)
+	fmt.Println(string(b))
+	// Output: {"zebra":1,"apple":2,"mango":3}
+}
@@ -1,6 +1,7 @@
 package maps
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -179,3 +180,170 @@ func TestKeys(t *testing.T) {
 		tt.Test(t)
 	}
 }
+
+func TestValues(t *testing.T) {
+	type args[K comparable, V any] struct {
+		m map[K]V
+	}
+
+	type testCaseForValues[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want []V
+	}
+
+	tests := []testkit.Testable{}
+
+	tests = append(tests, testkit.TestCase[testCaseForValues[string, int]]{
+		Describe: "case_string_int",
+		Cases: []testCaseForValues[string, int]{
+			{
+				name: "return slice",
+				args: args[string, int]{
+					m: map[string]int{
+						"foo":  123,
+						"bar":  456,
+						"fizz": 123,
+					},
+				},
+				want: []int{
+					123,
+					123,
+					456,
+				},
+			},
+			{
+				name: "empty map",
+				args: args[string, int]{
+					m: map[string]int{},
+				},
+				want: nil,
+			},
+			{
+				name: "nil",
+				args: args[string, int]{
+					m: nil,
+				},
+				want: nil,
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForValues[string, int]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				got := Values(tt.args.m)
+				if diff := cmp.Diff(got, tt.want, cmpopts.SortSlices(less[int])); diff != "" {
+					t.Errorf("Values() = %v, want %v, diff %s", got, tt.want, diff)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	m := map[int]string{
+		3: "c",
+		1: "a",
+		2: "b",
+	}
+
+	if diff := cmp.Diff(SortedKeys(m), []int{1, 2, 3}); diff != "" {
+		t.Errorf("SortedKeys() diff %s", diff)
+	}
+	desc := func(a, b int) bool { return a > b }
+	if diff := cmp.Diff(SortedKeysBy(m, desc), []int{3, 2, 1}); diff != "" {
+		t.Errorf("SortedKeysBy() diff %s", diff)
+	}
+	want := []Entry[int, string]{
+		{Key: 1, Value: "a"},
+		{Key: 2, Value: "b"},
+		{Key: 3, Value: "c"},
+	}
+	if diff := cmp.Diff(SortedEntries(m), want); diff != "" {
+		t.Errorf("SortedEntries() diff %s", diff)
+	}
+	if got := SortedKeys(map[int]string{}); got != nil {
+		t.Errorf("SortedKeys() = %v, want nil", got)
+	}
+	if got := Entries(map[int]string(nil)); got != nil {
+		t.Errorf("Entries() = %v, want nil", got)
+	}
+}
+
+func TestCombineStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		values  []int
+		want    map[string]int
+		wantErr error
+	}{
+		{
+			name:   "unique keys",
+			keys:   []string{"a", "b"},
+			values: []int{1, 2},
+			want:   map[string]int{"a": 1, "b": 2},
+		},
+		{
+			name:    "duplicate keys",
+			keys:    []string{"a", "b", "a"},
+			values:  []int{1, 2, 3},
+			wantErr: ErrDuplicateKey,
+		},
+		{
+			name:    "not same number of elements",
+			keys:    []string{"a"},
+			values:  []int{1, 2},
+			wantErr: ErrLengthMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CombineStrict(tt.keys, tt.values)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CombineStrict() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("CombineStrict() = %v, want %v, diff %s", got, tt.want, diff)
+			}
+		})
+	}
+
+	if _, err := Combine([]string{"a"}, []int{}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Combine() error = %v, want %v", err, ErrLengthMismatch)
+	}
+}
+
+func TestCombineWith(t *testing.T) {
+	sum := func(_ string, a, b int) int { return a + b }
+	got, err := CombineWith([]string{"a", "b", "a"}, []int{1, 2, 3}, sum)
+	if err != nil {
+		t.Fatalf("CombineWith() error = %v", err)
+	}
+	if diff := cmp.Diff(got, map[string]int{"a": 4, "b": 2}); diff != "" {
+		t.Errorf("CombineWith() diff %s", diff)
+	}
+	if _, err := CombineWith([]string{"a"}, nil, sum); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("CombineWith() error = %v, want %v", err, ErrLengthMismatch)
+	}
+}
+
+func TestCombineBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{
+		{1, "alice"},
+		{2, "bob"},
+	}
+	got := CombineBy(users, func(u user) int { return u.id }, func(u user) string { return u.name })
+	if diff := cmp.Diff(got, map[int]string{1: "alice", 2: "bob"}); diff != "" {
+		t.Errorf("CombineBy() diff %s", diff)
+	}
+}
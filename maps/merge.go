@@ -0,0 +1,161 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type (
+	// ConflictStrategy decides which value is kept when a key exists in more than one map
+	ConflictStrategy int
+
+	// SliceStrategy decides how DeepMerge combines two slices under the same key
+	SliceStrategy int
+)
+
+const (
+	// LastWins keeps the value of the map merged last
+	LastWins ConflictStrategy = iota
+	// FirstWins keeps the value of the map merged first
+	FirstWins
+	// ErrorOnConflict returns an error if a key exists in more than one map
+	ErrorOnConflict
+)
+
+const (
+	// SliceReplace replaces the slice with the one merged later
+	SliceReplace SliceStrategy = iota
+	// SliceAppend appends the slice merged later to the existing one
+	SliceAppend
+)
+
+// ErrConflict is returned when a key exists more than once and there is nothing to resolve the conflict,
+// such as with ErrorOnConflict
+var ErrConflict = errors.New("conflicting key")
+
+// Merge creates a map holding the entries of all given maps, resolving duplicate keys with strategy
+func Merge[K comparable, V any](strategy ConflictStrategy, ms ...map[K]V) (map[K]V, error) {
+	result := map[K]V{}
+	for _, m := range ms {
+		if err := MergeInto(result, m, strategy); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// MergeFunc creates a map holding the entries of all given maps.
+// If a key already exists, f decides the value from the existing one (a) and the incoming one (b).
+func MergeFunc[K comparable, V any](f func(key K, a, b V) V, ms ...map[K]V) map[K]V {
+	result := map[K]V{}
+	for _, m := range ms {
+		MergeIntoFunc(result, m, f)
+	}
+	return result
+}
+
+// MergeInto copies the entries of src into dst, resolving duplicate keys with strategy.
+// dst is left untouched if an error is returned.
+func MergeInto[K comparable, V any](dst, src map[K]V, strategy ConflictStrategy) error {
+	switch strategy {
+	case LastWins:
+		for k, v := range src {
+			dst[k] = v
+		}
+	case FirstWins:
+		for k, v := range src {
+			if _, ok := dst[k]; !ok {
+				dst[k] = v
+			}
+		}
+	case ErrorOnConflict:
+		for k := range src {
+			if _, ok := dst[k]; ok {
+				return fmt.Errorf("%w: %v", ErrConflict, k)
+			}
+		}
+		for k, v := range src {
+			dst[k] = v
+		}
+	default:
+		return fmt.Errorf("unknown conflict strategy: %d", strategy)
+	}
+	return nil
+}
+
+// MergeIntoFunc copies the entries of src into dst.
+// If a key already exists in dst, f decides the value from the existing one (a) and the incoming one (b).
+func MergeIntoFunc[K comparable, V any](dst, src map[K]V, f func(key K, a, b V) V) {
+	for k, v := range src {
+		if existing, ok := dst[k]; ok {
+			v = f(k, existing, v)
+		}
+		dst[k] = v
+	}
+}
+
+// DeepMerge merges map[string]any trees, such as decoded JSON or YAML documents.
+// Nested maps are merged recursively, slices are combined according to strategy
+// and any other value is replaced by the one merged later. The given maps are not modified.
+func DeepMerge(strategy SliceStrategy, ms ...map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, m := range ms {
+		deepMergeInto(result, m, strategy)
+	}
+	return result
+}
+
+func deepMergeInto(dst, src map[string]interface{}, strategy SliceStrategy) {
+	for k, v := range src {
+		existing, ok := dst[k]
+		if !ok {
+			dst[k] = deepCopy(v)
+			continue
+		}
+		if a, ok := existing.(map[string]interface{}); ok {
+			if b, ok := v.(map[string]interface{}); ok {
+				deepMergeInto(a, b, strategy)
+				continue
+			}
+		}
+		if strategy == SliceAppend {
+			if merged, ok := appendSlices(existing, v); ok {
+				dst[k] = merged
+				continue
+			}
+		}
+		dst[k] = deepCopy(v)
+	}
+}
+
+// appendSlices appends b to a if both are slices of the same type
+func appendSlices(a, b interface{}) (interface{}, bool) {
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if ra.Kind() != reflect.Slice || rb.Kind() != reflect.Slice || ra.Type() != rb.Type() {
+		return nil, false
+	}
+	rs := reflect.MakeSlice(ra.Type(), 0, ra.Len()+rb.Len())
+	rs = reflect.AppendSlice(rs, ra)
+	rs = reflect.AppendSlice(rs, reflect.ValueOf(deepCopy(b)))
+	return rs.Interface(), true
+}
+
+// deepCopy copies nested maps and []any, so the merged tree doesn't share them with its sources
+func deepCopy(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, e := range x {
+			m[k] = deepCopy(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(x))
+		for i, e := range x {
+			s[i] = deepCopy(e)
+		}
+		return s
+	}
+	return v
+}
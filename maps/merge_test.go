@@ -0,0 +1,165 @@
+package maps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMerge(t *testing.T) {
+	defaults := map[string]string{
+		"host": "localhost",
+		"port": "8080",
+	}
+	env := map[string]string{
+		"port": "9090",
+	}
+	user := map[string]string{
+		"port":  "3000",
+		"debug": "true",
+	}
+
+	tests := []struct {
+		name     string
+		strategy ConflictStrategy
+		want     map[string]string
+		wantErr  error
+	}{
+		{
+			name:     "last_wins",
+			strategy: LastWins,
+			want: map[string]string{
+				"host":  "localhost",
+				"port":  "3000",
+				"debug": "true",
+			},
+		},
+		{
+			name:     "first_wins",
+			strategy: FirstWins,
+			want: map[string]string{
+				"host":  "localhost",
+				"port":  "8080",
+				"debug": "true",
+			},
+		},
+		{
+			name:     "error_on_conflict",
+			strategy: ErrorOnConflict,
+			wantErr:  ErrConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge(tt.strategy, defaults, env, user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Merge() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Merge() = %v, want %v, diff %s", got, tt.want, diff)
+			}
+		})
+	}
+
+	if _, err := Merge(ConflictStrategy(-1), defaults); err == nil {
+		t.Error("Merge() error = nil, want error for unknown strategy")
+	}
+}
+
+func TestMergeInto(t *testing.T) {
+	dst := map[string]int{"a": 1}
+
+	if err := MergeInto(dst, map[string]int{"b": 2, "a": 3}, ErrorOnConflict); !errors.Is(err, ErrConflict) {
+		t.Errorf("MergeInto() error = %v, want %v", err, ErrConflict)
+	}
+	if diff := cmp.Diff(dst, map[string]int{"a": 1}); diff != "" {
+		t.Errorf("MergeInto() modified dst on error, diff %s", diff)
+	}
+
+	sum := func(_ string, a, b int) int { return a + b }
+	MergeIntoFunc(dst, map[string]int{"b": 2, "a": 3}, sum)
+	if diff := cmp.Diff(dst, map[string]int{"a": 4, "b": 2}); diff != "" {
+		t.Errorf("MergeIntoFunc() diff %s", diff)
+	}
+
+	got := MergeFunc(sum, map[string]int{"a": 1}, map[string]int{"a": 2}, map[string]int{"a": 3})
+	if diff := cmp.Diff(got, map[string]int{"a": 6}); diff != "" {
+		t.Errorf("MergeFunc() diff %s", diff)
+	}
+}
+
+func TestDeepMerge(t *testing.T) {
+	base := map[string]interface{}{
+		"name": "app",
+		"server": map[string]interface{}{
+			"host": "localhost",
+			"port": 8080,
+		},
+		"tags":  []interface{}{"a"},
+		"ports": []int{80},
+	}
+	override := map[string]interface{}{
+		"server": map[string]interface{}{
+			"port": 9090,
+			"tls": map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"tags":  []interface{}{"b"},
+		"ports": []int{443},
+		"name":  map[string]interface{}{"full": "application"},
+	}
+
+	tests := []struct {
+		name     string
+		strategy SliceStrategy
+		want     map[string]interface{}
+	}{
+		{
+			name:     "replace_slices",
+			strategy: SliceReplace,
+			want: map[string]interface{}{
+				"name": map[string]interface{}{"full": "application"},
+				"server": map[string]interface{}{
+					"host": "localhost",
+					"port": 9090,
+					"tls": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+				"tags":  []interface{}{"b"},
+				"ports": []int{443},
+			},
+		},
+		{
+			name:     "append_slices",
+			strategy: SliceAppend,
+			want: map[string]interface{}{
+				"name": map[string]interface{}{"full": "application"},
+				"server": map[string]interface{}{
+					"host": "localhost",
+					"port": 9090,
+					"tls": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+				"tags":  []interface{}{"a", "b"},
+				"ports": []int{80, 443},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeepMerge(tt.strategy, base, override)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("DeepMerge() diff %s", diff)
+			}
+		})
+	}
+
+	if port := base["server"].(map[string]interface{})["port"]; port != 8080 {
+		t.Errorf("DeepMerge() modified its source: port = %v", port)
+	}
+}
@@ -0,0 +1,164 @@
+package maps
+
+// MultiMap maps each key to several values. The list-valued flavor keeps every value in insertion order,
+// duplicates included; the set-valued flavor keeps each value at most once per key, also in insertion order.
+type MultiMap[K, V comparable] struct {
+	lists map[K][]V
+	// sets indexes the values of each key in the set-valued flavor, and is nil in the list-valued one
+	sets map[K]map[V]struct{}
+	size int
+}
+
+// NewListMultiMap creates an empty list-valued MultiMap
+func NewListMultiMap[K, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{lists: map[K][]V{}}
+}
+
+// NewSetMultiMap creates an empty set-valued MultiMap
+func NewSetMultiMap[K, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{lists: map[K][]V{}, sets: map[K]map[V]struct{}{}}
+}
+
+// NewListMultiMapFrom creates a list-valued MultiMap holding the entries of m
+func NewListMultiMapFrom[K, V comparable](m map[K][]V) *MultiMap[K, V] {
+	mm := NewListMultiMap[K, V]()
+	for k, vs := range m {
+		mm.AddAll(k, vs...)
+	}
+	return mm
+}
+
+// NewSetMultiMapFrom creates a set-valued MultiMap holding the entries of m. Duplicate values are dropped.
+func NewSetMultiMapFrom[K, V comparable](m map[K][]V) *MultiMap[K, V] {
+	mm := NewSetMultiMap[K, V]()
+	for k, vs := range m {
+		mm.AddAll(k, vs...)
+	}
+	return mm
+}
+
+// Add adds value to key. It reports false if the set-valued flavor already holds the entry.
+func (m *MultiMap[K, V]) Add(key K, value V) bool {
+	if m.sets != nil {
+		set, ok := m.sets[key]
+		if !ok {
+			set = map[V]struct{}{}
+			m.sets[key] = set
+		}
+		if _, ok := set[value]; ok {
+			return false
+		}
+		set[value] = struct{}{}
+	}
+	m.lists[key] = append(m.lists[key], value)
+	m.size++
+	return true
+}
+
+// AddAll adds values to key and returns the number of values added
+func (m *MultiMap[K, V]) AddAll(key K, values ...V) int {
+	n := 0
+	for _, v := range values {
+		if m.Add(key, v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Remove removes the first occurrence of value from key. It reports whether the entry existed.
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	vs := m.lists[key]
+	i := -1
+	for j := range vs {
+		if vs[j] == value {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return false
+	}
+	if len(vs) == 1 {
+		delete(m.lists, key)
+	} else {
+		m.lists[key] = append(vs[:i:i], vs[i+1:]...)
+	}
+	if m.sets != nil {
+		delete(m.sets[key], value)
+		if len(m.sets[key]) == 0 {
+			delete(m.sets, key)
+		}
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes key with all its values and returns them
+func (m *MultiMap[K, V]) RemoveAll(key K) []V {
+	vs, ok := m.lists[key]
+	if !ok {
+		return nil
+	}
+	delete(m.lists, key)
+	if m.sets != nil {
+		delete(m.sets, key)
+	}
+	m.size -= len(vs)
+	return vs
+}
+
+// Get returns a copy of the values of key
+func (m *MultiMap[K, V]) Get(key K) []V {
+	vs, ok := m.lists[key]
+	if !ok {
+		return nil
+	}
+	rs := make([]V, len(vs))
+	copy(rs, vs)
+	return rs
+}
+
+// Count returns the number of values of key
+func (m *MultiMap[K, V]) Count(key K) int {
+	return len(m.lists[key])
+}
+
+// ContainsKey checks if key has at least one value
+func (m *MultiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.lists[key]
+	return ok
+}
+
+// ContainsEntry checks if key holds value
+func (m *MultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	if m.sets != nil {
+		_, ok := m.sets[key][value]
+		return ok
+	}
+	for _, v := range m.lists[key] {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of key/value entries
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// Keys returns the list of all keys in no particular order
+func (m *MultiMap[K, V]) Keys() []K {
+	return Keys(m.lists)
+}
+
+// Map returns a copy of the entries as a plain map
+func (m *MultiMap[K, V]) Map() map[K][]V {
+	result := make(map[K][]V, len(m.lists))
+	for k := range m.lists {
+		result[k] = m.Get(k)
+	}
+	return result
+}
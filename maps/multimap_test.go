@@ -0,0 +1,107 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestListMultiMap(t *testing.T) {
+	m := NewListMultiMap[string, int]()
+	m.Add("a", 1)
+	if n := m.AddAll("a", 2, 1); n != 2 {
+		t.Errorf("AddAll() = %d, want 2", n)
+	}
+	m.Add("b", 3)
+
+	if diff := cmp.Diff(m.Get("a"), []int{1, 2, 1}); diff != "" {
+		t.Errorf("Get() diff %s", diff)
+	}
+	if m.Count("a") != 3 || m.Len() != 4 {
+		t.Errorf("Count() = %d, Len() = %d, want 3, 4", m.Count("a"), m.Len())
+	}
+	if !m.ContainsEntry("a", 2) || m.ContainsEntry("b", 2) || m.ContainsKey("c") {
+		t.Error("Contains reported a wrong result")
+	}
+
+	if !m.Remove("a", 1) || m.Remove("a", 5) {
+		t.Error("Remove() reported a wrong result")
+	}
+	if diff := cmp.Diff(m.Get("a"), []int{2, 1}); diff != "" {
+		t.Errorf("Get() after Remove() diff %s", diff)
+	}
+	if !m.Remove("b", 3) || m.ContainsKey("b") {
+		t.Error("Remove() of the last value kept the key")
+	}
+
+	got := m.Get("a")
+	got[0] = 100
+	if diff := cmp.Diff(m.Get("a"), []int{2, 1}); diff != "" {
+		t.Errorf("Get() doesn't return a copy, diff %s", diff)
+	}
+
+	if diff := cmp.Diff(m.RemoveAll("a"), []int{2, 1}); diff != "" {
+		t.Errorf("RemoveAll() diff %s", diff)
+	}
+	if m.Len() != 0 || m.Keys() != nil || m.Get("a") != nil {
+		t.Errorf("MultiMap isn't empty: %v", m.Map())
+	}
+}
+
+func TestSetMultiMap(t *testing.T) {
+	m := NewSetMultiMap[string, string]()
+	if !m.Add("go", "generics") || m.Add("go", "generics") {
+		t.Error("Add() reported a wrong result")
+	}
+	if n := m.AddAll("go", "generics", "channels", "channels"); n != 1 {
+		t.Errorf("AddAll() = %d, want 1", n)
+	}
+	if diff := cmp.Diff(m.Get("go"), []string{"generics", "channels"}); diff != "" {
+		t.Errorf("Get() diff %s", diff)
+	}
+	if !m.ContainsEntry("go", "channels") || m.Len() != 2 {
+		t.Errorf("ContainsEntry() = false or Len() = %d", m.Len())
+	}
+
+	m.Remove("go", "generics")
+	if m.ContainsEntry("go", "generics") {
+		t.Error("Remove() kept the entry")
+	}
+	if !m.Add("go", "generics") {
+		t.Error("Add() after Remove() = false, want true")
+	}
+	m.RemoveAll("go")
+	if !m.Add("go", "channels") {
+		t.Error("Add() after RemoveAll() = false, want true")
+	}
+}
+
+func TestMultiMapConversion(t *testing.T) {
+	src := map[int][]string{
+		1: {"one", "uno", "one"},
+		2: {"two"},
+	}
+
+	list := NewListMultiMapFrom(src)
+	if diff := cmp.Diff(list.Map(), src); diff != "" {
+		t.Errorf("list Map() diff %s", diff)
+	}
+
+	set := NewSetMultiMapFrom(src)
+	want := map[int][]string{
+		1: {"one", "uno"},
+		2: {"two"},
+	}
+	if diff := cmp.Diff(set.Map(), want); diff != "" {
+		t.Errorf("set Map() diff %s", diff)
+	}
+
+	inverted := NewSetMultiMapFrom(InvertMulti(map[string]int{"a": 1, "b": 1, "c": 2}))
+	if diff := cmp.Diff(inverted.Keys(), []int{1, 2}, cmpopts.SortSlices(less[int])); diff != "" {
+		t.Errorf("Keys() diff %s", diff)
+	}
+	if inverted.Count(1) != 2 {
+		t.Errorf("Count() = %d, want 2", inverted.Count(1))
+	}
+}
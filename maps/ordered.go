@@ -0,0 +1,291 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type (
+	// OrderedMap is a map which remembers the order in which keys were inserted.
+	// Get, Set and Delete run in constant time. The zero value is an empty map ready to use.
+	OrderedMap[K comparable, V any] struct {
+		entries map[K]*orderedEntry[K, V]
+		// root is the sentinel of a circular doubly linked list; root.next is the first entry
+		root *orderedEntry[K, V]
+	}
+
+	orderedEntry[K comparable, V any] struct {
+		prev, next *orderedEntry[K, V]
+		key        K
+		value      V
+	}
+)
+
+// NewOrderedMap creates an empty OrderedMap
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	m.lazyInit()
+	return m
+}
+
+// NewOrderedMapFrom creates an OrderedMap holding the given entries in order.
+// Later entries overwrite the values of earlier ones with the same key, keeping the position of the first.
+func NewOrderedMapFrom[K comparable, V any](es []Entry[K, V]) *OrderedMap[K, V] {
+	m := NewOrderedMap[K, V]()
+	for _, e := range es {
+		m.Set(e.Key, e.Value)
+	}
+	return m
+}
+
+// Get returns the value of key
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.entries[key]; ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has checks if key exists
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Set sets the value of key. A new key is added to the back; an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.entries[key]; ok {
+		e.value = value
+		return
+	}
+	m.lazyInit()
+	e := &orderedEntry[K, V]{key: key, value: value}
+	m.entries[key] = e
+	m.insertBefore(e, m.root)
+}
+
+// Delete removes key. It reports whether the key existed.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	delete(m.entries, key)
+	m.unlink(e)
+	return true
+}
+
+// Len returns the number of entries
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// MoveToFront moves key to the front. It reports whether the key exists.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	m.insertBefore(e, m.root.next)
+	return true
+}
+
+// MoveToBack moves key to the back. It reports whether the key exists.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	m.insertBefore(e, m.root)
+	return true
+}
+
+// Front returns the first entry. ok is false if the map is empty.
+func (m *OrderedMap[K, V]) Front() (e Entry[K, V], ok bool) {
+	if m.Len() == 0 {
+		return e, false
+	}
+	return Entry[K, V]{Key: m.root.next.key, Value: m.root.next.value}, true
+}
+
+// Back returns the last entry. ok is false if the map is empty.
+func (m *OrderedMap[K, V]) Back() (e Entry[K, V], ok bool) {
+	if m.Len() == 0 {
+		return e, false
+	}
+	return Entry[K, V]{Key: m.root.prev.key, Value: m.root.prev.value}, true
+}
+
+// Range calls f for each entry in order until f returns false
+func (m *OrderedMap[K, V]) Range(f func(key K, value V) bool) {
+	if m.root == nil {
+		return
+	}
+	for e := m.root.next; e != m.root; e = e.next {
+		if !f(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// Keys returns the list of all keys in order
+func (m *OrderedMap[K, V]) Keys() []K {
+	if m.Len() == 0 {
+		return nil
+	}
+	ks := make([]K, 0, m.Len())
+	m.Range(func(k K, _ V) bool {
+		ks = append(ks, k)
+		return true
+	})
+	return ks
+}
+
+// Values returns the list of all values in order
+func (m *OrderedMap[K, V]) Values() []V {
+	if m.Len() == 0 {
+		return nil
+	}
+	vs := make([]V, 0, m.Len())
+	m.Range(func(_ K, v V) bool {
+		vs = append(vs, v)
+		return true
+	})
+	return vs
+}
+
+// Entries returns the list of all key/value pairs in order
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	if m.Len() == 0 {
+		return nil
+	}
+	es := make([]Entry[K, V], 0, m.Len())
+	m.Range(func(k K, v V) bool {
+		es = append(es, Entry[K, V]{Key: k, Value: v})
+		return true
+	})
+	return es
+}
+
+// MarshalJSON encodes the map as a JSON object whose keys are in order.
+// Keys must encode to JSON strings or numbers, as with encoding/json.
+// It has a value receiver, so maps embedded by value in other structs are encoded as well.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	var err error
+	m.Range(func(k K, v V) bool {
+		var kb, vb []byte
+		if kb, err = marshalKey(k); err != nil {
+			return false
+		}
+		if vb, err = json.Marshal(v); err != nil {
+			return false
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(vb)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object, keeping the order of its keys.
+// Existing entries are kept; keys found in data are added or overwritten.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.lazyInit()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return nil
+	}
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot unmarshal %v into OrderedMap: want JSON object", t)
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k, err := unmarshalKey[K](t.(string))
+		if err != nil {
+			return err
+		}
+		var v V
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		m.Set(k, v)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+func (m *OrderedMap[K, V]) lazyInit() {
+	if m.root != nil {
+		return
+	}
+	m.entries = map[K]*orderedEntry[K, V]{}
+	m.root = &orderedEntry[K, V]{}
+	m.root.prev = m.root
+	m.root.next = m.root
+}
+
+func (m *OrderedMap[K, V]) insertBefore(e, mark *orderedEntry[K, V]) {
+	e.prev = mark.prev
+	e.next = mark
+	mark.prev.next = e
+	mark.prev = e
+}
+
+func (m *OrderedMap[K, V]) unlink(e *orderedEntry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+}
+
+func marshalKey[K comparable](k K) ([]byte, error) {
+	b, err := json.Marshal(k)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(b) > 0 && b[0] == '"':
+		return b, nil
+	case len(b) > 0 && (b[0] == '-' || (b[0] >= '0' && b[0] <= '9')):
+		return []byte(strconv.Quote(string(b))), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %T: keys must encode to JSON strings or numbers", k)
+}
+
+func unmarshalKey[K comparable](s string) (K, error) {
+	var k K
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return k, err
+	}
+	if err := json.Unmarshal(quoted, &k); err == nil {
+		return k, nil
+	}
+	if err := json.Unmarshal([]byte(s), &k); err != nil {
+		return k, fmt.Errorf("cannot unmarshal key %q into %T", s, k)
+	}
+	return k, nil
+}
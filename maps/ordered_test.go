@@ -0,0 +1,178 @@
+package maps
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	if diff := cmp.Diff(m.Keys(), []string{"c", "a", "b"}); diff != "" {
+		t.Errorf("Keys() diff %s", diff)
+	}
+	if diff := cmp.Diff(m.Values(), []int{3, 10, 2}); diff != "" {
+		t.Errorf("Values() diff %s", diff)
+	}
+	if v, ok := m.Get("a"); !ok || v != 10 {
+		t.Errorf("Get() = %v, %v, want 10, true", v, ok)
+	}
+	if _, ok := m.Get("z"); ok {
+		t.Error("Get() found a missing key")
+	}
+
+	if !m.MoveToFront("b") || !m.MoveToBack("c") || m.MoveToBack("z") {
+		t.Error("Move reported a wrong result")
+	}
+	want := []Entry[string, int]{
+		{Key: "b", Value: 2},
+		{Key: "a", Value: 10},
+		{Key: "c", Value: 3},
+	}
+	if diff := cmp.Diff(m.Entries(), want); diff != "" {
+		t.Errorf("Entries() diff %s", diff)
+	}
+	if e, ok := m.Front(); !ok || e.Key != "b" {
+		t.Errorf("Front() = %v, %v, want b", e, ok)
+	}
+	if e, ok := m.Back(); !ok || e.Key != "c" {
+		t.Errorf("Back() = %v, %v, want c", e, ok)
+	}
+
+	if !m.Delete("a") || m.Delete("a") {
+		t.Error("Delete() reported a wrong result")
+	}
+	if diff := cmp.Diff(m.Keys(), []string{"b", "c"}); diff != "" {
+		t.Errorf("Keys() after Delete() diff %s", diff)
+	}
+	if m.Len() != 2 || m.Has("a") || !m.Has("b") {
+		t.Errorf("Len() = %d, Has(a) = %v, Has(b) = %v", m.Len(), m.Has("a"), m.Has("b"))
+	}
+
+	var visited []string
+	m.Range(func(k string, _ int) bool {
+		visited = append(visited, k)
+		return false
+	})
+	if diff := cmp.Diff(visited, []string{"b"}); diff != "" {
+		t.Errorf("Range() didn't stop, diff %s", diff)
+	}
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	var m OrderedMap[int, string]
+	if m.Len() != 0 || m.Keys() != nil || m.Delete(1) {
+		t.Error("zero value isn't an empty map")
+	}
+	if _, ok := m.Front(); ok {
+		t.Error("Front() of an empty map reported ok")
+	}
+	m.Set(1, "a")
+	if v, ok := m.Get(1); !ok || v != "a" {
+		t.Errorf("Get() = %v, %v, want a, true", v, ok)
+	}
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := NewOrderedMapFrom([]Entry[string, interface{}]{
+		{Key: "name", Value: "toolkit"},
+		{Key: "version", Value: 1},
+		{Key: "authors", Value: []string{"usk81"}},
+		{Key: "active", Value: true},
+	})
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	want := `{"name":"toolkit","version":1,"authors":["usk81"],"active":true}`
+	if string(b) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", b, want)
+	}
+
+	var got OrderedMap[string, json.RawMessage]
+	if err := json.Unmarshal([]byte(`{"z": 1, "a": {"nested": true}, "m": null}`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if diff := cmp.Diff(got.Keys(), []string{"z", "a", "m"}); diff != "" {
+		t.Errorf("UnmarshalJSON() keys diff %s", diff)
+	}
+	if v, _ := got.Get("a"); string(v) != `{"nested": true}` {
+		t.Errorf("UnmarshalJSON() a = %s", v)
+	}
+
+	numbers := NewOrderedMap[int, string]()
+	numbers.Set(2, "two")
+	numbers.Set(1, "one")
+	b, err = json.Marshal(numbers)
+	if err != nil || string(b) != `{"2":"two","1":"one"}` {
+		t.Errorf("MarshalJSON() = %s, %v", b, err)
+	}
+	decoded := NewOrderedMap[int, string]()
+	if err := json.Unmarshal(b, decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if diff := cmp.Diff(decoded.Entries(), numbers.Entries()); diff != "" {
+		t.Errorf("UnmarshalJSON() diff %s", diff)
+	}
+
+	if err := json.Unmarshal([]byte(`{"x": "one"}`), decoded); err == nil {
+		t.Error("UnmarshalJSON() error = nil, want error for invalid key")
+	}
+	if err := json.Unmarshal([]byte(`[1]`), decoded); err == nil {
+		t.Error("UnmarshalJSON() error = nil, want error for non-object")
+	}
+	if _, err := json.Marshal(NewOrderedMapFrom([]Entry[bool, int]{{Key: true, Value: 1}})); err == nil {
+		t.Error("MarshalJSON() error = nil, want error for bool keys")
+	}
+}
+
+func TestOrderedMapJSONEscapedKeys(t *testing.T) {
+	m := NewOrderedMapFrom([]Entry[string, int]{
+		{Key: "a\x01b", Value: 1},
+		{Key: "bell\a", Value: 2},
+		{Key: "tab\t\v", Value: 3},
+		{Key: "quote\"\\", Value: 4},
+	})
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	decoded := NewOrderedMap[string, int]()
+	if err := json.Unmarshal(b, decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if diff := cmp.Diff(decoded.Entries(), m.Entries()); diff != "" {
+		t.Errorf("UnmarshalJSON() diff %s", diff)
+	}
+}
+
+func TestOrderedMapJSONByValue(t *testing.T) {
+	type payload struct {
+		M     OrderedMap[string, int]
+		Empty OrderedMap[string, int]
+	}
+	p := payload{M: *NewOrderedMapFrom([]Entry[string, int]{{Key: "z", Value: 1}, {Key: "a", Value: 2}})}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if want := `{"M":{"z":1,"a":2},"Empty":{}}`; string(b) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", b, want)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if diff := cmp.Diff(decoded.M.Entries(), p.M.Entries()); diff != "" {
+		t.Errorf("UnmarshalJSON() diff %s", diff)
+	}
+}
@@ -0,0 +1,330 @@
+package maps
+
+import (
+	"github.com/usk81/toolkit/iterator"
+	"golang.org/x/exp/constraints"
+)
+
+type (
+	// SortedMap is a map ordered by key, backed by an AVL tree.
+	// Get, Put, Delete, Floor and Ceiling run in O(log n).
+	SortedMap[K comparable, V any] struct {
+		root *treeNode[K, V]
+		len  int
+		less func(a, b K) bool
+	}
+
+	treeNode[K comparable, V any] struct {
+		key         K
+		value       V
+		left, right *treeNode[K, V]
+		height      int
+	}
+
+	// sortedMapIterator walks the tree in order with an explicit stack
+	sortedMapIterator[K comparable, V any] struct {
+		stack []*treeNode[K, V]
+		less  func(a, b K) bool
+		hi    K
+		hasHi bool
+		value Entry[K, V]
+	}
+)
+
+// NewSortedMap creates an empty SortedMap ordered by the natural order of keys
+func NewSortedMap[K constraints.Ordered, V any]() *SortedMap[K, V] {
+	return NewSortedMapFunc[K, V](func(a, b K) bool { return a < b })
+}
+
+// NewSortedMapFunc creates an empty SortedMap ordered by less
+func NewSortedMapFunc[K comparable, V any](less func(a, b K) bool) *SortedMap[K, V] {
+	return &SortedMap[K, V]{less: less}
+}
+
+// Len returns the number of entries
+func (m *SortedMap[K, V]) Len() int {
+	return m.len
+}
+
+// Get returns the value of key
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	if n := m.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has checks if key exists
+func (m *SortedMap[K, V]) Has(key K) bool {
+	return m.find(key) != nil
+}
+
+// Put sets the value of key
+func (m *SortedMap[K, V]) Put(key K, value V) {
+	var added bool
+	m.root, added = m.put(m.root, key, value)
+	if added {
+		m.len++
+	}
+}
+
+// Delete removes key. It reports whether the key existed.
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	var deleted bool
+	m.root, deleted = m.delete(m.root, key)
+	if deleted {
+		m.len--
+	}
+	return deleted
+}
+
+// Min returns the entry with the smallest key. ok is false if the map is empty.
+func (m *SortedMap[K, V]) Min() (e Entry[K, V], ok bool) {
+	if m.root == nil {
+		return e, false
+	}
+	return minNode(m.root).entry(), true
+}
+
+// Max returns the entry with the largest key. ok is false if the map is empty.
+func (m *SortedMap[K, V]) Max() (e Entry[K, V], ok bool) {
+	n := m.root
+	if n == nil {
+		return e, false
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.entry(), true
+}
+
+// Floor returns the entry with the largest key less than or equal to key. ok is false if there is none.
+func (m *SortedMap[K, V]) Floor(key K) (e Entry[K, V], ok bool) {
+	var found *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.less(key, n.key) {
+			n = n.left
+		} else {
+			found = n
+			n = n.right
+		}
+	}
+	if found == nil {
+		return e, false
+	}
+	return found.entry(), true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key. ok is false if there is none.
+func (m *SortedMap[K, V]) Ceiling(key K) (e Entry[K, V], ok bool) {
+	var found *treeNode[K, V]
+	for n := m.root; n != nil; {
+		if m.less(n.key, key) {
+			n = n.right
+		} else {
+			found = n
+			n = n.left
+		}
+	}
+	if found == nil {
+		return e, false
+	}
+	return found.entry(), true
+}
+
+// Iter returns an iterator over all entries in ascending order of keys.
+// The map must not be modified while iterating.
+func (m *SortedMap[K, V]) Iter() iterator.Iterator[Entry[K, V]] {
+	it := &sortedMapIterator[K, V]{less: m.less}
+	it.pushLeft(m.root)
+	return it
+}
+
+// Range returns an iterator over the entries whose key is in [lo, hi), in ascending order of keys.
+// The map must not be modified while iterating.
+func (m *SortedMap[K, V]) Range(lo, hi K) iterator.Iterator[Entry[K, V]] {
+	it := &sortedMapIterator[K, V]{less: m.less, hi: hi, hasHi: true}
+	for n := m.root; n != nil; {
+		if m.less(n.key, lo) {
+			n = n.right
+		} else {
+			it.stack = append(it.stack, n)
+			n = n.left
+		}
+	}
+	return it
+}
+
+// Keys returns the list of all keys in ascending order
+func (m *SortedMap[K, V]) Keys() []K {
+	if m.len == 0 {
+		return nil
+	}
+	ks := make([]K, 0, m.len)
+	for it := m.Iter(); it.Next(); {
+		ks = append(ks, it.Value().Key)
+	}
+	return ks
+}
+
+// Entries returns the list of all key/value pairs in ascending order of keys
+func (m *SortedMap[K, V]) Entries() []Entry[K, V] {
+	if m.len == 0 {
+		return nil
+	}
+	es := make([]Entry[K, V], 0, m.len)
+	for it := m.Iter(); it.Next(); {
+		es = append(es, it.Value())
+	}
+	return es
+}
+
+func (m *SortedMap[K, V]) find(key K) *treeNode[K, V] {
+	for n := m.root; n != nil; {
+		switch {
+		case m.less(key, n.key):
+			n = n.left
+		case m.less(n.key, key):
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (m *SortedMap[K, V]) put(n *treeNode[K, V], key K, value V) (*treeNode[K, V], bool) {
+	if n == nil {
+		return &treeNode[K, V]{key: key, value: value, height: 1}, true
+	}
+	var added bool
+	switch {
+	case m.less(key, n.key):
+		n.left, added = m.put(n.left, key, value)
+	case m.less(n.key, key):
+		n.right, added = m.put(n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+	return n.rebalance(), added
+}
+
+func (m *SortedMap[K, V]) delete(n *treeNode[K, V], key K) (*treeNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch {
+	case m.less(key, n.key):
+		n.left, deleted = m.delete(n.left, key)
+	case m.less(n.key, key):
+		n.right, deleted = m.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right, _ = m.delete(n.right, successor.key)
+		deleted = true
+	}
+	return n.rebalance(), deleted
+}
+
+func minNode[K comparable, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *treeNode[K, V]) entry() Entry[K, V] {
+	return Entry[K, V]{Key: n.key, Value: n.value}
+}
+
+func (n *treeNode[K, V]) balanceFactor() int {
+	return nodeHeight(n.left) - nodeHeight(n.right)
+}
+
+func (n *treeNode[K, V]) updateHeight() {
+	l, r := nodeHeight(n.left), nodeHeight(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+}
+
+func (n *treeNode[K, V]) rebalance() *treeNode[K, V] {
+	n.updateHeight()
+	switch bf := n.balanceFactor(); {
+	case bf > 1:
+		if n.left.balanceFactor() < 0 {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case bf < -1:
+		if n.right.balanceFactor() > 0 {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *treeNode[K, V]) rotateLeft() *treeNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.updateHeight()
+	r.updateHeight()
+	return r
+}
+
+func (n *treeNode[K, V]) rotateRight() *treeNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.updateHeight()
+	l.updateHeight()
+	return l
+}
+
+func nodeHeight[K comparable, V any](n *treeNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// Next moves to the next entry
+func (it *sortedMapIterator[K, V]) Next() bool {
+	if len(it.stack) == 0 {
+		return false
+	}
+	n := it.stack[len(it.stack)-1]
+	if it.hasHi && !it.less(n.key, it.hi) {
+		it.stack = nil
+		return false
+	}
+	it.stack = it.stack[:len(it.stack)-1]
+	it.pushLeft(n.right)
+	it.value = n.entry()
+	return true
+}
+
+// Value gets the current entry
+func (it *sortedMapIterator[K, V]) Value() Entry[K, V] {
+	return it.value
+}
+
+func (it *sortedMapIterator[K, V]) pushLeft(n *treeNode[K, V]) {
+	for ; n != nil; n = n.left {
+		it.stack = append(it.stack, n)
+	}
+}
@@ -0,0 +1,228 @@
+package maps
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/usk81/toolkit/iterator"
+)
+
+// checkAVL verifies the ordering and balance invariants and returns the height of n
+func checkAVL[K comparable, V any](t *testing.T, m *SortedMap[K, V], n *treeNode[K, V]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+	if n.left != nil && !m.less(n.left.key, n.key) || n.right != nil && !m.less(n.key, n.right.key) {
+		t.Fatalf("ordering violated at %v", n.key)
+	}
+	l, r := checkAVL(t, m, n.left), checkAVL(t, m, n.right)
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("balance violated at %v: %d, %d", n.key, l, r)
+	}
+	h := l + 1
+	if r >= l {
+		h = r + 1
+	}
+	if h != n.height {
+		t.Fatalf("height of %v = %d, want %d", n.key, n.height, h)
+	}
+	return h
+}
+
+func TestSortedMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewSortedMap[int, int]()
+	want := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			_, existed := want[k]
+			delete(want, k)
+			if got := m.Delete(k); got != existed {
+				t.Fatalf("Delete(%d) = %v, want %v", k, got, existed)
+			}
+		} else {
+			want[k] = i
+			m.Put(k, i)
+		}
+	}
+	checkAVL(t, m, m.root)
+
+	if m.Len() != len(want) {
+		t.Errorf("Len() = %d, want %d", m.Len(), len(want))
+	}
+	if diff := cmp.Diff(m.Keys(), SortedKeys(want)); diff != "" {
+		t.Errorf("Keys() diff %s", diff)
+	}
+	for k, v := range want {
+		if got, ok := m.Get(k); !ok || got != v {
+			t.Fatalf("Get(%d) = %v, %v, want %v, true", k, got, ok, v)
+		}
+	}
+	if diff := cmp.Diff(m.Entries(), SortedEntries(want)); diff != "" {
+		t.Errorf("Entries() diff %s", diff)
+	}
+}
+
+func TestSortedMapQueries(t *testing.T) {
+	m := NewSortedMap[int, string]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		m.Put(k, "v")
+	}
+
+	tests := []struct {
+		name   string
+		got    func() (Entry[int, string], bool)
+		want   int
+		wantOk bool
+	}{
+		{name: "Min", got: m.Min, want: 10, wantOk: true},
+		{name: "Max", got: m.Max, want: 50, wantOk: true},
+		{name: "Floor_exact", got: func() (Entry[int, string], bool) { return m.Floor(30) }, want: 30, wantOk: true},
+		{name: "Floor_between", got: func() (Entry[int, string], bool) { return m.Floor(35) }, want: 30, wantOk: true},
+		{name: "Floor_below_min", got: func() (Entry[int, string], bool) { return m.Floor(5) }, wantOk: false},
+		{name: "Ceiling_exact", got: func() (Entry[int, string], bool) { return m.Ceiling(30) }, want: 30, wantOk: true},
+		{name: "Ceiling_between", got: func() (Entry[int, string], bool) { return m.Ceiling(35) }, want: 40, wantOk: true},
+		{name: "Ceiling_above_max", got: func() (Entry[int, string], bool) { return m.Ceiling(55) }, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.got()
+			if ok != tt.wantOk || (ok && got.Key != tt.want) {
+				t.Errorf("%s() = %v, %v, want %v, %v", tt.name, got.Key, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	keys := func(it iterator.Iterator[Entry[int, string]]) []int {
+		var ks []int
+		for it.Next() {
+			ks = append(ks, it.Value().Key)
+		}
+		return ks
+	}
+	if diff := cmp.Diff(keys(m.Range(15, 40)), []int{20, 30}); diff != "" {
+		t.Errorf("Range() diff %s", diff)
+	}
+	if diff := cmp.Diff(keys(m.Range(10, 11)), []int{10}); diff != "" {
+		t.Errorf("Range() diff %s", diff)
+	}
+	if got := keys(m.Range(60, 70)); got != nil {
+		t.Errorf("Range() = %v, want nil", got)
+	}
+
+	empty := NewSortedMapFunc[string, int](func(a, b string) bool { return a > b })
+	if _, ok := empty.Min(); ok {
+		t.Error("Min() of an empty map reported ok")
+	}
+	empty.Put("a", 1)
+	empty.Put("b", 2)
+	if diff := cmp.Diff(empty.Keys(), []string{"b", "a"}); diff != "" {
+		t.Errorf("Keys() with custom order diff %s", diff)
+	}
+}
+
+// sortedSlice is the baseline the benchmarks compare SortedMap against
+type sortedSlice struct {
+	keys   []int
+	values []int
+}
+
+func (s *sortedSlice) put(k, v int) {
+	i := sort.SearchInts(s.keys, k)
+	if i < len(s.keys) && s.keys[i] == k {
+		s.values[i] = v
+		return
+	}
+	s.keys = append(s.keys, 0)
+	copy(s.keys[i+1:], s.keys[i:])
+	s.keys[i] = k
+	s.values = append(s.values, 0)
+	copy(s.values[i+1:], s.values[i:])
+	s.values[i] = v
+}
+
+func (s *sortedSlice) get(k int) (int, bool) {
+	i := sort.SearchInts(s.keys, k)
+	if i < len(s.keys) && s.keys[i] == k {
+		return s.values[i], true
+	}
+	return 0, false
+}
+
+const benchmarkSize = 10000
+
+func BenchmarkSortedMapPut(b *testing.B) {
+	keys := rand.New(rand.NewSource(1)).Perm(benchmarkSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m := NewSortedMap[int, int]()
+		for _, k := range keys {
+			m.Put(k, k)
+		}
+	}
+}
+
+func BenchmarkSortedSlicePut(b *testing.B) {
+	keys := rand.New(rand.NewSource(1)).Perm(benchmarkSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := &sortedSlice{}
+		for _, k := range keys {
+			s.put(k, k)
+		}
+	}
+}
+
+func BenchmarkSortedMapGet(b *testing.B) {
+	m := NewSortedMap[int, int]()
+	for k := 0; k < benchmarkSize; k++ {
+		m.Put(k, k)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Get(i % benchmarkSize)
+	}
+}
+
+func BenchmarkSortedSliceGet(b *testing.B) {
+	s := &sortedSlice{}
+	for k := 0; k < benchmarkSize; k++ {
+		s.put(k, k)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.get(i % benchmarkSize)
+	}
+}
+
+func BenchmarkSortedMapRange(b *testing.B) {
+	m := NewSortedMap[int, int]()
+	for k := 0; k < benchmarkSize; k++ {
+		m.Put(k, k)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for it := m.Range(1000, 2000); it.Next(); {
+			_ = it.Value()
+		}
+	}
+}
+
+func BenchmarkSortedSliceRange(b *testing.B) {
+	s := &sortedSlice{}
+	for k := 0; k < benchmarkSize; k++ {
+		s.put(k, k)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lo, hi := sort.SearchInts(s.keys, 1000), sort.SearchInts(s.keys, 2000)
+		for j := lo; j < hi; j++ {
+			_ = s.values[j]
+		}
+	}
+}
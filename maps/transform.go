@@ -0,0 +1,80 @@
+package maps
+
+import "fmt"
+
+// Filter creates a map of the entries for which pred returns true
+func Filter[K comparable, V any](m map[K]V, pred func(key K, value V) bool) map[K]V {
+	result := map[K]V{}
+	for k, v := range m {
+		if pred(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Reject creates a map of the entries for which pred returns false
+func Reject[K comparable, V any](m map[K]V, pred func(key K, value V) bool) map[K]V {
+	return Filter(m, func(k K, v V) bool {
+		return !pred(k, v)
+	})
+}
+
+// MapValues creates a map with the same keys and the values converted by f
+func MapValues[K comparable, V, U any](m map[K]V, f func(value V) U) map[K]U {
+	result := make(map[K]U, len(m))
+	for k, v := range m {
+		result[k] = f(v)
+	}
+	return result
+}
+
+// MapKeys creates a map with the keys converted by f and the same values.
+// Collisions are resolved with merge as in Transform.
+func MapKeys[K, K2 comparable, V any](m map[K]V, f func(key K) K2, merge func(key K2, a, b V) V) (map[K2]V, error) {
+	return Transform(m, func(k K, v V) (K2, V) {
+		return f(k), v
+	}, merge)
+}
+
+// Transform creates a map by converting every entry with f.
+// If f maps several entries to the same key, merge decides the value from the two colliding ones (a and b).
+// Since maps are iterated in random order, merge should not depend on which value comes first.
+// If merge is nil, an error wrapping ErrConflict is returned instead.
+func Transform[K, K2 comparable, V, V2 any](m map[K]V, f func(key K, value V) (K2, V2), merge func(key K2, a, b V2) V2) (map[K2]V2, error) {
+	result := make(map[K2]V2, len(m))
+	for k, v := range m {
+		k2, v2 := f(k, v)
+		if existing, ok := result[k2]; ok {
+			if merge == nil {
+				return nil, fmt.Errorf("%w: %v", ErrConflict, k2)
+			}
+			v2 = merge(k2, existing, v2)
+		}
+		result[k2] = v2
+	}
+	return result, nil
+}
+
+// PickKeys creates a map of the entries whose key is one of keys. Keys which don't exist in m are ignored.
+func PickKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// OmitKeys creates a map of the entries whose key is none of keys
+func OmitKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	for _, k := range keys {
+		delete(result, k)
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package maps
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilter(t *testing.T) {
+	m := map[string]int{
+		"foo":  1,
+		"bar":  2,
+		"fizz": 3,
+		"buzz": 4,
+	}
+	even := func(_ string, v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name string
+		got  map[string]int
+		want map[string]int
+	}{
+		{
+			name: "Filter",
+			got:  Filter(m, even),
+			want: map[string]int{"bar": 2, "buzz": 4},
+		},
+		{
+			name: "Reject",
+			got:  Reject(m, even),
+			want: map[string]int{"foo": 1, "fizz": 3},
+		},
+		{
+			name: "Filter_nil",
+			got:  Filter(nil, even),
+			want: map[string]int{},
+		},
+		{
+			name: "PickKeys",
+			got:  PickKeys(m, "foo", "bar", "missing"),
+			want: map[string]int{"foo": 1, "bar": 2},
+		},
+		{
+			name: "OmitKeys",
+			got:  OmitKeys(m, "foo", "bar", "missing"),
+			want: map[string]int{"fizz": 3, "buzz": 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.got, tt.want); diff != "" {
+				t.Errorf("%s() = %v, want %v, diff %s", tt.name, tt.got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	got := MapValues(map[string]int{"a": 1, "b": 2}, strconv.Itoa)
+	if diff := cmp.Diff(got, map[string]string{"a": "1", "b": "2"}); diff != "" {
+		t.Errorf("MapValues() diff %s", diff)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	sum := func(_ string, a, b int) int { return a + b }
+
+	tests := []struct {
+		name    string
+		m       map[string]int
+		merge   func(key string, a, b int) int
+		want    map[string]int
+		wantErr error
+	}{
+		{
+			name: "no_collision",
+			m:    map[string]int{"a": 1, "b": 2},
+			want: map[string]int{"A": 1, "B": 2},
+		},
+		{
+			name:    "collision_error",
+			m:       map[string]int{"a": 1, "A": 2},
+			wantErr: ErrConflict,
+		},
+		{
+			name:  "collision_merged",
+			m:     map[string]int{"a": 1, "A": 2, "b": 3},
+			merge: sum,
+			want:  map[string]int{"A": 3, "B": 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MapKeys(tt.m, strings.ToUpper, tt.merge)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MapKeys() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("MapKeys() diff %s", diff)
+			}
+		})
+	}
+}
+
+func TestTransform(t *testing.T) {
+	got, err := Transform(map[string]int{"a": 1, "b": 2}, func(k string, v int) (int, string) {
+		return v, k
+	}, nil)
+	if err != nil {
+		t.Fatalf("Transform() error = %v", err)
+	}
+	if diff := cmp.Diff(got, map[int]string{1: "a", 2: "b"}); diff != "" {
+		t.Errorf("Transform() diff %s", diff)
+	}
+
+	parity, err := Transform(map[string]int{"a": 1, "b": 2, "c": 3}, func(k string, v int) (bool, []string) {
+		return v%2 == 0, []string{k}
+	}, func(_ bool, a, b []string) []string {
+		return append(a, b...)
+	})
+	if err != nil {
+		t.Fatalf("Transform() error = %v", err)
+	}
+	for _, vs := range parity {
+		sort.Strings(vs)
+	}
+	if diff := cmp.Diff(parity, map[bool][]string{false: {"a", "c"}, true: {"b"}}); diff != "" {
+		t.Errorf("Transform() diff %s", diff)
+	}
+}
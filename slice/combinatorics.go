@@ -0,0 +1,216 @@
+package slice
+
+import "github.com/usk81/toolkit/iterator"
+
+// indexIterator enumerates index tuples lazily. advance moves idx to the next tuple in place
+// and reports false once the sequence is exhausted; build turns the current tuple into a value.
+type indexIterator[T any] struct {
+	idx     []int
+	started bool
+	done    bool
+	advance func(idx []int) bool
+	build   func(idx []int) []T
+	value   []T
+}
+
+// Next moves to the next tuple
+func (it *indexIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if it.started && !it.advance(it.idx) {
+		it.done = true
+		it.value = nil
+		return false
+	}
+	it.started = true
+	it.value = it.build(it.idx)
+	return true
+}
+
+// Value gets the current tuple. Every tuple is a new slice.
+func (it *indexIterator[T]) Value() []T {
+	return it.value
+}
+
+// Permutations returns all orderings of vs in lexicographic order of their positions
+func Permutations[T any](vs []T) [][]T {
+	return iterator.Collect(PermutationsIter(vs))
+}
+
+// PermutationsIter is the lazy version of Permutations
+func PermutationsIter[T any](vs []T) iterator.Iterator[[]T] {
+	idx := make([]int, len(vs))
+	for i := range idx {
+		idx[i] = i
+	}
+	return &indexIterator[T]{
+		idx:     idx,
+		advance: nextPermutation,
+		build:   pick(vs),
+	}
+}
+
+// Combinations returns all k-element subsets of vs, keeping the order of vs within each subset
+func Combinations[T any](vs []T, k int) [][]T {
+	return iterator.Collect(CombinationsIter(vs, k))
+}
+
+// CombinationsIter is the lazy version of Combinations
+func CombinationsIter[T any](vs []T, k int) iterator.Iterator[[]T] {
+	n := len(vs)
+	if k < 0 || k > n {
+		return iterator.Slice([][]T{})
+	}
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	return &indexIterator[T]{
+		idx: idx,
+		advance: func(idx []int) bool {
+			i := k - 1
+			for i >= 0 && idx[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return false
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+			return true
+		},
+		build: pick(vs),
+	}
+}
+
+// CombinationsWithRepetition returns all k-element multisets of vs, where an element can be picked more than once
+func CombinationsWithRepetition[T any](vs []T, k int) [][]T {
+	return iterator.Collect(CombinationsWithRepetitionIter(vs, k))
+}
+
+// CombinationsWithRepetitionIter is the lazy version of CombinationsWithRepetition
+func CombinationsWithRepetitionIter[T any](vs []T, k int) iterator.Iterator[[]T] {
+	n := len(vs)
+	if k < 0 || (n == 0 && k > 0) {
+		return iterator.Slice([][]T{})
+	}
+	return &indexIterator[T]{
+		idx: make([]int, k),
+		advance: func(idx []int) bool {
+			i := k - 1
+			for i >= 0 && idx[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return false
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[i]
+			}
+			return true
+		},
+		build: pick(vs),
+	}
+}
+
+// CartesianProduct returns every tuple made of one element from each of vss, varying the last slice fastest
+func CartesianProduct[T any](vss ...[]T) [][]T {
+	return iterator.Collect(CartesianProductIter(vss...))
+}
+
+// CartesianProductIter is the lazy version of CartesianProduct
+func CartesianProductIter[T any](vss ...[]T) iterator.Iterator[[]T] {
+	for _, vs := range vss {
+		if len(vs) == 0 {
+			return iterator.Slice([][]T{})
+		}
+	}
+	return &indexIterator[T]{
+		idx: make([]int, len(vss)),
+		advance: func(idx []int) bool {
+			for i := len(idx) - 1; i >= 0; i-- {
+				idx[i]++
+				if idx[i] < len(vss[i]) {
+					return true
+				}
+				idx[i] = 0
+			}
+			return false
+		},
+		build: func(idx []int) []T {
+			rs := make([]T, len(idx))
+			for i, j := range idx {
+				rs[i] = vss[i][j]
+			}
+			return rs
+		},
+	}
+}
+
+// PowerSet returns all subsets of vs ordered by size, starting with the empty set
+func PowerSet[T any](vs []T) [][]T {
+	return iterator.Collect(PowerSetIter(vs))
+}
+
+// PowerSetIter is the lazy version of PowerSet
+func PowerSetIter[T any](vs []T) iterator.Iterator[[]T] {
+	return &powerSetIterator[T]{vs: vs, current: CombinationsIter(vs, 0)}
+}
+
+type powerSetIterator[T any] struct {
+	vs      []T
+	k       int
+	current iterator.Iterator[[]T]
+}
+
+// Next moves to the next subset
+func (it *powerSetIterator[T]) Next() bool {
+	for !it.current.Next() {
+		if it.k >= len(it.vs) {
+			return false
+		}
+		it.k++
+		it.current = CombinationsIter(it.vs, it.k)
+	}
+	return true
+}
+
+// Value gets the current subset
+func (it *powerSetIterator[T]) Value() []T {
+	return it.current.Value()
+}
+
+// nextPermutation rearranges idx into the next lexicographic permutation
+func nextPermutation(idx []int) bool {
+	i := len(idx) - 2
+	for i >= 0 && idx[i] >= idx[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(idx) - 1
+	for idx[j] <= idx[i] {
+		j--
+	}
+	idx[i], idx[j] = idx[j], idx[i]
+	for l, r := i+1, len(idx)-1; l < r; l, r = l+1, r-1 {
+		idx[l], idx[r] = idx[r], idx[l]
+	}
+	return true
+}
+
+// pick returns a function which collects the elements of vs at the given indices
+func pick[T any](vs []T) func(idx []int) []T {
+	return func(idx []int) []T {
+		rs := make([]T, len(idx))
+		for i, j := range idx {
+			rs[i] = vs[j]
+		}
+		return rs
+	}
+}
@@ -0,0 +1,205 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/usk81/toolkit/iterator"
+)
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		name string
+		vs   []string
+		want [][]string
+	}{
+		{
+			name: "three",
+			vs:   []string{"a", "b", "c"},
+			want: [][]string{
+				{"a", "b", "c"},
+				{"a", "c", "b"},
+				{"b", "a", "c"},
+				{"b", "c", "a"},
+				{"c", "a", "b"},
+				{"c", "b", "a"},
+			},
+		},
+		{
+			name: "duplicated_values",
+			vs:   []string{"a", "a"},
+			want: [][]string{
+				{"a", "a"},
+				{"a", "a"},
+			},
+		},
+		{
+			name: "empty",
+			vs:   []string{},
+			want: [][]string{
+				{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Permutations(tt.vs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Permutations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	vs := []int{1, 2, 3, 4}
+	tests := []struct {
+		name string
+		k    int
+		want [][]int
+	}{
+		{
+			name: "k_2",
+			k:    2,
+			want: [][]int{
+				{1, 2},
+				{1, 3},
+				{1, 4},
+				{2, 3},
+				{2, 4},
+				{3, 4},
+			},
+		},
+		{
+			name: "k_4",
+			k:    4,
+			want: [][]int{
+				{1, 2, 3, 4},
+			},
+		},
+		{
+			name: "k_0",
+			k:    0,
+			want: [][]int{
+				{},
+			},
+		},
+		{
+			name: "k_too_large",
+			k:    5,
+			want: nil,
+		},
+		{
+			name: "k_negative",
+			k:    -1,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Combinations(vs, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Combinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithRepetition(t *testing.T) {
+	got := CombinationsWithRepetition([]string{"a", "b", "c"}, 2)
+	want := [][]string{
+		{"a", "a"},
+		{"a", "b"},
+		{"a", "c"},
+		{"b", "b"},
+		{"b", "c"},
+		{"c", "c"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CombinationsWithRepetition() = %v, want %v", got, want)
+	}
+	if got := CombinationsWithRepetition([]string{}, 2); got != nil {
+		t.Errorf("CombinationsWithRepetition() = %v, want nil", got)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		vss  [][]string
+		want [][]string
+	}{
+		{
+			name: "two",
+			vss: [][]string{
+				{"linux", "darwin"},
+				{"amd64", "arm64"},
+			},
+			want: [][]string{
+				{"linux", "amd64"},
+				{"linux", "arm64"},
+				{"darwin", "amd64"},
+				{"darwin", "arm64"},
+			},
+		},
+		{
+			name: "includes_empty",
+			vss: [][]string{
+				{"linux"},
+				{},
+			},
+			want: nil,
+		},
+		{
+			name: "no_slices",
+			vss:  nil,
+			want: [][]string{
+				{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CartesianProduct(tt.vss...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CartesianProduct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	got := PowerSet([]int{1, 2, 3})
+	want := [][]int{
+		{},
+		{1},
+		{2},
+		{3},
+		{1, 2},
+		{1, 3},
+		{2, 3},
+		{1, 2, 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PowerSet() = %v, want %v", got, want)
+	}
+	if got := PowerSet([]int{}); !reflect.DeepEqual(got, [][]int{{}}) {
+		t.Errorf("PowerSet() = %v, want [[]]", got)
+	}
+}
+
+func TestCombinatoricsIterLazy(t *testing.T) {
+	// 20! permutations can't be materialized, but the iterator only computes what is consumed
+	iter := PermutationsIter(seq(0, 20))
+	n := 0
+	for n < 3 && iter.Next() {
+		n++
+	}
+	if want := append(seq(0, 17), 18, 17, 19); !reflect.DeepEqual(iter.Value(), want) {
+		t.Errorf("PermutationsIter() third value = %v, want %v", iter.Value(), want)
+	}
+
+	evens := iterator.Filter(CombinationsIter(seq(0, 5), 2), func(v []int) bool {
+		return (v[0]+v[1])%2 == 0
+	})
+	if got := iterator.Collect(evens); len(got) != 4 {
+		t.Errorf("CombinationsIter() filtered = %v, want 4 pairs", got)
+	}
+}
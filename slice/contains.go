@@ -0,0 +1,126 @@
+package slice
+
+// linearScanLimit is the number of comparisons up to which nested loops are used instead of a hash set
+const linearScanLimit = 1024
+
+// Contains checks if v exists in vs. It is Exists with the arguments in the conventional order.
+func Contains[T comparable](vs []T, v T) bool {
+	return Exists(v, vs)
+}
+
+// ContainsAll checks if every element of xs exists in vs
+func ContainsAll[T comparable](vs []T, xs ...T) bool {
+	if useLinearScan(len(vs), len(xs)) {
+		for _, x := range xs {
+			if !Exists(x, vs) {
+				return false
+			}
+		}
+		return true
+	}
+	set := toSet(vs)
+	for _, x := range xs {
+		if _, ok := set[x]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny checks if at least one element of xs exists in vs
+func ContainsAny[T comparable](vs []T, xs ...T) bool {
+	if useLinearScan(len(vs), len(xs)) {
+		for _, x := range xs {
+			if Exists(x, vs) {
+				return true
+			}
+		}
+		return false
+	}
+	set := toSet(vs)
+	for _, x := range xs {
+		if _, ok := set[x]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsFunc checks if at least one element of vs satisfies pred
+func ContainsFunc[T any](vs []T, pred func(v T) bool) bool {
+	return FindIndex(vs, pred) >= 0
+}
+
+// EqualUnordered checks if a and b hold the same elements the same number of times, in any order
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if useLinearScan(len(a), len(b)) {
+		used := make([]bool, len(b))
+	outer:
+		for _, x := range a {
+			for i, y := range b {
+				if !used[i] && x == y {
+					used[i] = true
+					continue outer
+				}
+			}
+			return false
+		}
+		return true
+	}
+	counts := make(map[T]int, len(a))
+	for _, x := range a {
+		counts[x]++
+	}
+	for _, y := range b {
+		if counts[y] == 0 {
+			return false
+		}
+		counts[y]--
+	}
+	return true
+}
+
+// IsSubset checks if every element of a exists in b
+func IsSubset[T comparable](a, b []T) bool {
+	return ContainsAll(b, a...)
+}
+
+// IsSuperset checks if every element of b exists in a
+func IsSuperset[T comparable](a, b []T) bool {
+	return ContainsAll(a, b...)
+}
+
+// HasDuplicates checks if any element appears in vs more than once
+func HasDuplicates[T comparable](vs []T) bool {
+	if useLinearScan(len(vs), len(vs)) {
+		for i := range vs {
+			if Exists(vs[i], vs[i+1:]) {
+				return true
+			}
+		}
+		return false
+	}
+	seen := make(map[T]struct{}, len(vs))
+	for _, v := range vs {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+	}
+	return false
+}
+
+func useLinearScan(n, m int) bool {
+	return n <= linearScanLimit && m <= linearScanLimit && n*m <= linearScanLimit
+}
+
+func toSet[T comparable](vs []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(vs))
+	for _, v := range vs {
+		set[v] = struct{}{}
+	}
+	return set
+}
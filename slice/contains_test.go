@@ -0,0 +1,177 @@
+package slice
+
+import "testing"
+
+// seq returns [from, from+1, ..., to-1]
+func seq(from, to int) []int {
+	vs := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+func TestContains(t *testing.T) {
+	large := seq(0, 2000)
+
+	tests := []struct {
+		name    string
+		vs      []int
+		xs      []int
+		wantAll bool
+		wantAny bool
+	}{
+		{
+			name:    "all",
+			vs:      []int{1, 2, 3},
+			xs:      []int{3, 1},
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			name:    "some",
+			vs:      []int{1, 2, 3},
+			xs:      []int{3, 4},
+			wantAll: false,
+			wantAny: true,
+		},
+		{
+			name:    "none",
+			vs:      []int{1, 2, 3},
+			xs:      []int{4, 5},
+			wantAll: false,
+			wantAny: false,
+		},
+		{
+			name:    "empty_xs",
+			vs:      []int{1, 2, 3},
+			xs:      nil,
+			wantAll: true,
+			wantAny: false,
+		},
+		{
+			name:    "large_all",
+			vs:      large,
+			xs:      seq(500, 1500),
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			name:    "large_some",
+			vs:      large,
+			xs:      seq(1500, 2500),
+			wantAll: false,
+			wantAny: true,
+		},
+		{
+			name:    "large_none",
+			vs:      large,
+			xs:      seq(3000, 4000),
+			wantAll: false,
+			wantAny: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsAll(tt.vs, tt.xs...); got != tt.wantAll {
+				t.Errorf("ContainsAll() = %v, want %v", got, tt.wantAll)
+			}
+			if got := ContainsAny(tt.vs, tt.xs...); got != tt.wantAny {
+				t.Errorf("ContainsAny() = %v, want %v", got, tt.wantAny)
+			}
+			if got := IsSubset(tt.xs, tt.vs); got != tt.wantAll {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.wantAll)
+			}
+			if got := IsSuperset(tt.vs, tt.xs); got != tt.wantAll {
+				t.Errorf("IsSuperset() = %v, want %v", got, tt.wantAll)
+			}
+		})
+	}
+
+	if !Contains([]string{"foo", "bar"}, "bar") {
+		t.Error("Contains() = false, want true")
+	}
+	if !ContainsFunc([]int{1, 2, 3}, func(v int) bool { return v > 2 }) {
+		t.Error("ContainsFunc() = false, want true")
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{
+			name: "same_order",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"a", "b", "c"},
+			want: true,
+		},
+		{
+			name: "different_order",
+			a:    []string{"a", "b", "b"},
+			b:    []string{"b", "a", "b"},
+			want: true,
+		},
+		{
+			name: "different_counts",
+			a:    []string{"a", "a", "b"},
+			b:    []string{"a", "b", "b"},
+			want: false,
+		},
+		{
+			name: "different_length",
+			a:    []string{"a"},
+			b:    []string{"a", "a"},
+			want: false,
+		},
+		{
+			name: "empty",
+			a:    nil,
+			b:    []string{},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualUnordered(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualUnordered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	a, b := seq(0, 2000), seq(0, 2000)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	if !EqualUnordered(a, b) {
+		t.Error("EqualUnordered() = false, want true for large inputs")
+	}
+	b[0] = -1
+	if EqualUnordered(a, b) {
+		t.Error("EqualUnordered() = true, want false for large inputs")
+	}
+}
+
+func TestHasDuplicates(t *testing.T) {
+	tests := []struct {
+		name string
+		vs   []int
+		want bool
+	}{
+		{name: "unique", vs: []int{1, 2, 3}, want: false},
+		{name: "duplicated", vs: []int{1, 2, 1}, want: true},
+		{name: "empty", vs: nil, want: false},
+		{name: "large_unique", vs: seq(0, 2000), want: false},
+		{name: "large_duplicated", vs: append(seq(0, 2000), 1999), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasDuplicates(tt.vs); got != tt.want {
+				t.Errorf("HasDuplicates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package slice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// EditOp is the kind of an edit in an edit script
+	EditOp int
+
+	// Edit is a single step of an edit script.
+	// OldIndex is -1 for insertions and NewIndex is -1 for deletions.
+	Edit[T any] struct {
+		Op       EditOp
+		OldIndex int
+		NewIndex int
+		Value    T
+	}
+
+	// KeyedEdit describes what happened to the element identified by Key.
+	// Old and OldIndex are unset for insertions, New and NewIndex for deletions.
+	KeyedEdit[K comparable, T any] struct {
+		Key      K
+		Op       EditOp
+		Changed  bool
+		Old      T
+		New      T
+		OldIndex int
+		NewIndex int
+	}
+)
+
+const (
+	// EditEqual keeps an element
+	EditEqual EditOp = iota
+	// EditInsert inserts an element
+	EditInsert
+	// EditDelete deletes an element
+	EditDelete
+	// EditMove moves an element to another position. Only reported by DiffKeyed.
+	EditMove
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	case EditMove:
+		return "move"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Diff returns the shortest edit script which turns old into new, using the Myers algorithm.
+// It takes O((N+M)·D) time and O(N+M) space, where D is the number of insertions and deletions.
+func Diff[T comparable](old, new []T) []Edit[T] {
+	return DiffFunc(old, new, func(a, b T) bool { return a == b })
+}
+
+// DiffFunc works like Diff, but compares elements with eq
+func DiffFunc[T any](old, new []T, eq func(a, b T) bool) []Edit[T] {
+	ops := myers(len(old), len(new), func(i, j int) bool { return eq(old[i], new[j]) })
+	es := make([]Edit[T], len(ops))
+	for i, op := range ops {
+		es[i] = toEdit(op, old, new)
+	}
+	return es
+}
+
+// DiffKeyed compares two versions of a slice whose elements are identified by key, so reordered elements are
+// reported as moves rather than as a deletion and an insertion. Elements whose key exists in both versions are
+// reported as EditEqual if they keep their relative order and as EditMove otherwise, and Changed is set if eq
+// reports that their values differ. Deletions come first in the order of old, followed by the elements of new in order.
+// If eq is nil, reflect.DeepEqual is used. It returns an error if a key appears more than once in either version.
+func DiffKeyed[K comparable, T any](old, new []T, key func(v T) K, eq func(a, b T) bool) ([]KeyedEdit[K, T], error) {
+	if eq == nil {
+		eq = func(a, b T) bool { return reflect.DeepEqual(a, b) }
+	}
+	oldKeys, oldIndex, err := indexKeys(old, key)
+	if err != nil {
+		return nil, fmt.Errorf("argument #1 (old): %w", err)
+	}
+	newKeys, newIndex, err := indexKeys(new, key)
+	if err != nil {
+		return nil, fmt.Errorf("argument #2 (new): %w", err)
+	}
+
+	// keys which keep their relative order are the longest common subsequence of both key sequences
+	kept := map[K]struct{}{}
+	for _, op := range myers(len(oldKeys), len(newKeys), func(i, j int) bool { return oldKeys[i] == newKeys[j] }) {
+		if op.op == EditEqual {
+			kept[oldKeys[op.oldIndex]] = struct{}{}
+		}
+	}
+
+	es := []KeyedEdit[K, T]{}
+	for i, k := range oldKeys {
+		if _, ok := newIndex[k]; !ok {
+			es = append(es, KeyedEdit[K, T]{Key: k, Op: EditDelete, Old: old[i], OldIndex: i, NewIndex: -1})
+		}
+	}
+	for j, k := range newKeys {
+		i, ok := oldIndex[k]
+		if !ok {
+			es = append(es, KeyedEdit[K, T]{Key: k, Op: EditInsert, New: new[j], OldIndex: -1, NewIndex: j})
+			continue
+		}
+		op := EditMove
+		if _, ok := kept[k]; ok {
+			op = EditEqual
+		}
+		es = append(es, KeyedEdit[K, T]{
+			Key:      k,
+			Op:       op,
+			Changed:  !eq(old[i], new[j]),
+			Old:      old[i],
+			New:      new[j],
+			OldIndex: i,
+			NewIndex: j,
+		})
+	}
+	return es, nil
+}
+
+// FormatDiff renders an edit script in a unified-diff-like format, which is handy for test failure output.
+// Each element is printed on its own line, prefixed with "+" for insertions, "-" for deletions and " " otherwise.
+func FormatDiff[T any](es []Edit[T]) string {
+	var sb strings.Builder
+	for _, e := range es {
+		prefix := " "
+		switch e.Op {
+		case EditInsert:
+			prefix = "+"
+		case EditDelete:
+			prefix = "-"
+		}
+		fmt.Fprintf(&sb, "%s %v\n", prefix, e.Value)
+	}
+	return sb.String()
+}
+
+type editOp struct {
+	op       EditOp
+	oldIndex int
+	newIndex int
+}
+
+func toEdit[T any](o editOp, old, new []T) Edit[T] {
+	e := Edit[T]{Op: o.op, OldIndex: o.oldIndex, NewIndex: o.newIndex}
+	if o.op == EditInsert {
+		e.Value = new[o.newIndex]
+	} else {
+		e.Value = old[o.oldIndex]
+	}
+	return e
+}
+
+// myers computes the shortest edit script between sequences of length n and m. eq(i, j) compares old[i] and new[j].
+// It uses the linear-space refinement of the algorithm, which recursively splits both sequences at the middle
+// snake of an optimal path, so it runs in O((n+m)·D) time and O(n+m) space, where D is the number of edits.
+func myers(n, m int, eq func(i, j int) bool) []editOp {
+	size := (n+m+1)/2 + 1
+	md := &myersDiff{
+		eq:     eq,
+		fwd:    make([]int, 2*size+1),
+		bwd:    make([]int, 2*size+1),
+		offset: size,
+		ops:    make([]editOp, 0, n+m),
+	}
+	md.compare(0, n, 0, m)
+	return md.ops
+}
+
+// myersDiff holds the state shared by the recursive steps of myers.
+// fwd and bwd are reused by every call of middleSnake, so they are only valid inside it.
+type myersDiff struct {
+	eq     func(i, j int) bool
+	fwd    []int
+	bwd    []int
+	offset int
+	ops    []editOp
+}
+
+// compare appends the edit script which turns old[x0:x1] into new[y0:y1]
+func (md *myersDiff) compare(x0, x1, y0, y1 int) {
+	for x0 < x1 && y0 < y1 && md.eq(x0, y0) {
+		md.ops = append(md.ops, editOp{op: EditEqual, oldIndex: x0, newIndex: y0})
+		x0++
+		y0++
+	}
+	suffixX := x1
+	for x0 < x1 && y0 < y1 && md.eq(x1-1, y1-1) {
+		x1--
+		y1--
+	}
+
+	switch {
+	case x0 == x1 || y0 == y1:
+		for x := x0; x < x1; x++ {
+			md.ops = append(md.ops, editOp{op: EditDelete, oldIndex: x, newIndex: -1})
+		}
+		for y := y0; y < y1; y++ {
+			md.ops = append(md.ops, editOp{op: EditInsert, oldIndex: -1, newIndex: y})
+		}
+	default:
+		// both halves have fewer edits than the whole, as the common prefix and suffix are stripped
+		x, y, u, v := md.middleSnake(x0, x1, y0, y1)
+		md.compare(x0, x, y0, y)
+		for ; x < u; x, y = x+1, y+1 {
+			md.ops = append(md.ops, editOp{op: EditEqual, oldIndex: x, newIndex: y})
+		}
+		md.compare(u, x1, v, y1)
+	}
+
+	for ; x1 < suffixX; x1, y1 = x1+1, y1+1 {
+		md.ops = append(md.ops, editOp{op: EditEqual, oldIndex: x1, newIndex: y1})
+	}
+}
+
+// middleSnake runs the search from both ends of old[x0:x1] and new[y0:y1] at once and returns the start (x, y)
+// and end (u, v) of the snake where the paths meet, which lies on a shortest edit path.
+func (md *myersDiff) middleSnake(x0, x1, y0, y1 int) (x, y, u, v int) {
+	n, m := x1-x0, y1-y0
+	delta := n - m
+	odd := delta%2 != 0
+	fwd, bwd, off := md.fwd, md.bwd, md.offset
+	fwd[off+1], bwd[off+1] = 0, 0
+
+	for d := 0; d <= (n+m+1)/2; d++ {
+		// forward search; fwd[off+k] is the furthest x reached on diagonal k = x - y
+		for k := -d; k <= d; k += 2 {
+			var px int
+			if k == -d || (k != d && fwd[off+k-1] < fwd[off+k+1]) {
+				px = fwd[off+k+1]
+			} else {
+				px = fwd[off+k-1] + 1
+			}
+			py := px - k
+			sx, sy := px, py
+			for px < n && py < m && md.eq(x0+px, y0+py) {
+				px++
+				py++
+			}
+			fwd[off+k] = px
+			if rk := delta - k; odd && -(d-1) <= rk && rk <= d-1 && px+bwd[off+rk] >= n {
+				return x0 + sx, y0 + sy, x0 + px, y0 + py
+			}
+		}
+		// backward search in reversed coordinates; bwd[off+k] is the furthest x reached from the end on diagonal k
+		for k := -d; k <= d; k += 2 {
+			var px int
+			if k == -d || (k != d && bwd[off+k-1] < bwd[off+k+1]) {
+				px = bwd[off+k+1]
+			} else {
+				px = bwd[off+k-1] + 1
+			}
+			py := px - k
+			sx, sy := px, py
+			for px < n && py < m && md.eq(x1-px-1, y1-py-1) {
+				px++
+				py++
+			}
+			bwd[off+k] = px
+			if fk := delta - k; !odd && -d <= fk && fk <= d && px+fwd[off+fk] >= n {
+				return x1 - px, y1 - py, x1 - sx, y1 - sy
+			}
+		}
+	}
+	panic("slice: middle snake not found")
+}
+
+func indexKeys[K comparable, T any](vs []T, key func(v T) K) ([]K, map[K]int, error) {
+	keys := make([]K, len(vs))
+	index := make(map[K]int, len(vs))
+	for i, v := range vs {
+		k := key(v)
+		if j, ok := index[k]; ok {
+			return nil, nil, fmt.Errorf("duplicate key %v at [%d] and [%d]", k, j, i)
+		}
+		keys[i] = k
+		index[k] = i
+	}
+	return keys, index, nil
+}
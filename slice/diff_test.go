@@ -0,0 +1,229 @@
+package slice
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// applyEdits rebuilds both versions from an edit script
+func applyEdits[T any](es []Edit[T]) (old, new []T) {
+	old, new = []T{}, []T{}
+	for _, e := range es {
+		if e.Op != EditInsert {
+			old = append(old, e.Value)
+		}
+		if e.Op != EditDelete {
+			new = append(new, e.Value)
+		}
+	}
+	return old, new
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		old       []string
+		new       []string
+		wantEdits int
+	}{
+		{
+			name:      "myers_paper",
+			old:       strings.Split("ABCABBA", ""),
+			new:       strings.Split("CBABAC", ""),
+			wantEdits: 5,
+		},
+		{
+			name:      "same",
+			old:       []string{"a", "b"},
+			new:       []string{"a", "b"},
+			wantEdits: 0,
+		},
+		{
+			name:      "all_inserted",
+			old:       nil,
+			new:       []string{"a", "b"},
+			wantEdits: 2,
+		},
+		{
+			name:      "all_deleted",
+			old:       []string{"a", "b"},
+			new:       nil,
+			wantEdits: 2,
+		},
+		{
+			name:      "empty",
+			old:       nil,
+			new:       nil,
+			wantEdits: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := Diff(tt.old, tt.new)
+			edits := 0
+			for _, e := range es {
+				if e.Op != EditEqual {
+					edits++
+				}
+			}
+			if edits != tt.wantEdits {
+				t.Errorf("Diff() made %d edits, want %d\n%s", edits, tt.wantEdits, FormatDiff(es))
+			}
+			old, new := applyEdits(es)
+			if !reflect.DeepEqual(old, append([]string{}, tt.old...)) || !reflect.DeepEqual(new, append([]string{}, tt.new...)) {
+				t.Errorf("Diff() doesn't reproduce the inputs: %v, %v\n%s", old, new, FormatDiff(es))
+			}
+		})
+	}
+}
+
+func TestDiffIndices(t *testing.T) {
+	got := Diff([]int{1, 2, 3}, []int{1, 3, 4})
+	want := []Edit[int]{
+		{Op: EditEqual, OldIndex: 0, NewIndex: 0, Value: 1},
+		{Op: EditDelete, OldIndex: 1, NewIndex: -1, Value: 2},
+		{Op: EditEqual, OldIndex: 2, NewIndex: 1, Value: 3},
+		{Op: EditInsert, OldIndex: -1, NewIndex: 2, Value: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+}
+
+func TestDiffShortest(t *testing.T) {
+	// lcs is the length of the longest common subsequence computed by dynamic programming
+	lcs := func(a, b []int) int {
+		prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+		for i := range a {
+			for j := range b {
+				switch {
+				case a[i] == b[j]:
+					cur[j+1] = prev[j] + 1
+				case prev[j+1] > cur[j]:
+					cur[j+1] = prev[j+1]
+				default:
+					cur[j+1] = cur[j]
+				}
+			}
+			prev, cur = cur, prev
+		}
+		return prev[len(b)]
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		a, b := make([]int, r.Intn(20)), make([]int, r.Intn(20))
+		for j := range a {
+			a[j] = r.Intn(4)
+		}
+		for j := range b {
+			b[j] = r.Intn(4)
+		}
+		es := Diff(a, b)
+		if got, want := len(es), len(a)+len(b)-lcs(a, b); got != want {
+			t.Fatalf("Diff(%v, %v) has %d steps, want %d\n%s", a, b, got, want, FormatDiff(es))
+		}
+		old, new := applyEdits(es)
+		if !reflect.DeepEqual(old, append([]int{}, a...)) || !reflect.DeepEqual(new, append([]int{}, b...)) {
+			t.Fatalf("Diff(%v, %v) doesn't reproduce the inputs\n%s", a, b, FormatDiff(es))
+		}
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	// mostly different inputs need thousands of edits, so the search must not keep its state for each of them
+	old, new := seq(0, 4000), seq(3990, 7990)
+	es := Diff(old, new)
+	edits := 0
+	for _, e := range es {
+		if e.Op != EditEqual {
+			edits++
+		}
+	}
+	if want := 7980; edits != want {
+		t.Errorf("Diff() made %d edits, want %d", edits, want)
+	}
+	gotOld, gotNew := applyEdits(es)
+	if !reflect.DeepEqual(gotOld, old) || !reflect.DeepEqual(gotNew, new) {
+		t.Error("Diff() doesn't reproduce the inputs")
+	}
+}
+
+func TestDiffKeyed(t *testing.T) {
+	type record struct {
+		name  string
+		value string
+	}
+	key := func(r record) string { return r.name }
+	eq := func(a, b record) bool { return a.value == b.value }
+
+	old := []record{
+		{"a", "1"},
+		{"b", "2"},
+		{"c", "3"},
+		{"d", "4"},
+	}
+	new := []record{
+		{"a", "1"},
+		{"c", "3"},
+		{"d", "40"},
+		{"b", "2"},
+		{"e", "5"},
+	}
+
+	got, err := DiffKeyed(old, new, key, eq)
+	if err != nil {
+		t.Fatalf("DiffKeyed() error = %v", err)
+	}
+	type summary struct {
+		key     string
+		op      EditOp
+		changed bool
+	}
+	gotSummary := make([]summary, len(got))
+	for i, e := range got {
+		gotSummary[i] = summary{e.Key, e.Op, e.Changed}
+	}
+	want := []summary{
+		{"a", EditEqual, false},
+		{"c", EditEqual, false},
+		{"d", EditEqual, true},
+		{"b", EditMove, false},
+		{"e", EditInsert, false},
+	}
+	if !reflect.DeepEqual(gotSummary, want) {
+		t.Errorf("DiffKeyed() = %v, want %v", gotSummary, want)
+	}
+
+	got, err = DiffKeyed(old, new, key, nil)
+	if err != nil {
+		t.Fatalf("DiffKeyed() error = %v", err)
+	}
+	for i, e := range got {
+		if e.Changed != want[i].changed {
+			t.Errorf("DiffKeyed() with nil eq reports %s changed = %v, want %v", e.Key, e.Changed, want[i].changed)
+		}
+	}
+
+	got, err = DiffKeyed(old, old[:2], key, eq)
+	if err != nil {
+		t.Fatalf("DiffKeyed() error = %v", err)
+	}
+	if got[0].Op != EditDelete || got[0].Key != "c" || got[0].OldIndex != 2 || got[0].NewIndex != -1 {
+		t.Errorf("DiffKeyed() first edit = %+v, want deletion of c", got[0])
+	}
+
+	if _, err := DiffKeyed(append(old, record{"a", "9"}), new, key, eq); err == nil {
+		t.Error("DiffKeyed() error = nil, want duplicate key error")
+	}
+}
+
+func TestFormatDiff(t *testing.T) {
+	got := FormatDiff(Diff([]string{"foo", "bar"}, []string{"foo", "baz"}))
+	want := "  foo\n- bar\n+ baz\n"
+	if got != want {
+		t.Errorf("FormatDiff() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+package slice
+
+import "golang.org/x/exp/slices"
+
+// Run is a value repeated Count times in a row
+type Run[T comparable] struct {
+	Value T
+	Count int
+}
+
+// RunLengthEncode compresses consecutive equal elements into runs
+func RunLengthEncode[T comparable](vs []T) []Run[T] {
+	if vs == nil {
+		return nil
+	}
+	rs := []Run[T]{}
+	for _, v := range vs {
+		if n := len(rs); n > 0 && rs[n-1].Value == v {
+			rs[n-1].Count++
+			continue
+		}
+		rs = append(rs, Run[T]{Value: v, Count: 1})
+	}
+	return rs
+}
+
+// RunLengthDecode expands runs back into a slice. Runs with a count of zero or less are skipped.
+func RunLengthDecode[T comparable](runs []Run[T]) []T {
+	if runs == nil {
+		return nil
+	}
+	n := 0
+	for _, r := range runs {
+		if r.Count > 0 {
+			n += r.Count
+		}
+	}
+	rs := make([]T, 0, n)
+	for _, r := range runs {
+		for i := 0; i < r.Count; i++ {
+			rs = append(rs, r.Value)
+		}
+	}
+	return rs
+}
+
+// Frequencies counts how many times each element appears.
+// keys holds the distinct elements in the order they were first seen.
+func Frequencies[T comparable](vs []T) (counts map[T]int, keys []T) {
+	counts = map[T]int{}
+	keys = []T{}
+	for _, v := range vs {
+		if _, ok := counts[v]; !ok {
+			keys = append(keys, v)
+		}
+		counts[v]++
+	}
+	return counts, keys
+}
+
+// Mode returns the most frequent element. Ties are broken by the element seen first.
+// ok is false if vs is empty.
+func Mode[T comparable](vs []T) (mode T, ok bool) {
+	counts, keys := Frequencies(vs)
+	best := 0
+	for _, k := range keys {
+		if counts[k] > best {
+			mode, best = k, counts[k]
+		}
+	}
+	return mode, best > 0
+}
+
+// MostCommon returns the n most frequent elements with their counts, in descending order of count.
+// Ties are ordered by the element seen first. If n < 0, all elements are returned.
+func MostCommon[T comparable](vs []T, n int) []Pair[T, int] {
+	counts, keys := Frequencies(vs)
+	rs := make([]Pair[T, int], len(keys))
+	for i, k := range keys {
+		rs[i] = Pair[T, int]{First: k, Second: counts[k]}
+	}
+	slices.SortStableFunc(rs, func(a, b Pair[T, int]) bool {
+		return a.Second > b.Second
+	})
+	if n >= 0 && n < len(rs) {
+		rs = rs[:n]
+	}
+	return rs
+}
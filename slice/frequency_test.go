@@ -0,0 +1,163 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/usk81/toolkit/testkit"
+)
+
+func TestRunLengthEncode(t *testing.T) {
+	type args[T comparable] struct {
+		vs []T
+	}
+	type testCaseForRunLengthEncode[T comparable] struct {
+		name string
+		args args[T]
+		want []Run[T]
+	}
+
+	tests := []testkit.Testable{}
+
+	// string
+	tests = append(tests, testkit.TestCase[testCaseForRunLengthEncode[string]]{
+		Describe: "case_string",
+		Cases: []testCaseForRunLengthEncode[string]{
+			{
+				name: "runs",
+				args: args[string]{
+					vs: []string{"a", "a", "b", "c", "c", "c", "a"},
+				},
+				want: []Run[string]{
+					{Value: "a", Count: 2},
+					{Value: "b", Count: 1},
+					{Value: "c", Count: 3},
+					{Value: "a", Count: 1},
+				},
+			},
+			{
+				name: "empty_slice",
+				args: args[string]{
+					vs: []string{},
+				},
+				want: []Run[string]{},
+			},
+			{
+				name: "nil",
+				args: args[string]{
+					vs: nil,
+				},
+				want: nil,
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForRunLengthEncode[string]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				got := RunLengthEncode(tt.args.vs)
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("RunLengthEncode() = %v, want %v", got, tt.want)
+				}
+				if decoded := RunLengthDecode(got); !reflect.DeepEqual(decoded, tt.args.vs) {
+					t.Errorf("RunLengthDecode() = %v, want %v", decoded, tt.args.vs)
+				}
+			})
+		},
+	})
+
+	// int
+	tests = append(tests, testkit.TestCase[testCaseForRunLengthEncode[int]]{
+		Describe: "case_int",
+		Cases: []testCaseForRunLengthEncode[int]{
+			{
+				name: "runs",
+				args: args[int]{
+					vs: []int{0, 0, 0, 1},
+				},
+				want: []Run[int]{
+					{Value: 0, Count: 3},
+					{Value: 1, Count: 1},
+				},
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForRunLengthEncode[int]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				got := RunLengthEncode(tt.args.vs)
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("RunLengthEncode() = %v, want %v", got, tt.want)
+				}
+				if decoded := RunLengthDecode(got); !reflect.DeepEqual(decoded, tt.args.vs) {
+					t.Errorf("RunLengthDecode() = %v, want %v", decoded, tt.args.vs)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	vs := []string{"warn", "info", "error", "info", "warn", "info"}
+
+	counts, keys := Frequencies(vs)
+	if want := map[string]int{"warn": 2, "info": 3, "error": 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("Frequencies() counts = %v, want %v", counts, want)
+	}
+	if want := []string{"warn", "info", "error"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Frequencies() keys = %v, want %v", keys, want)
+	}
+
+	if got, ok := Mode(vs); !ok || got != "info" {
+		t.Errorf("Mode() = %v, %v, want info, true", got, ok)
+	}
+	if got, ok := Mode([]int{2, 1, 1, 2}); !ok || got != 2 {
+		t.Errorf("Mode() = %v, %v, want 2, true", got, ok)
+	}
+	if _, ok := Mode([]int{}); ok {
+		t.Error("Mode() ok = true, want false for empty input")
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	vs := []string{"b", "a", "c", "a", "b", "a", "d"}
+
+	tests := []struct {
+		name string
+		n    int
+		want []Pair[string, int]
+	}{
+		{
+			name: "top_2",
+			n:    2,
+			want: []Pair[string, int]{
+				{First: "a", Second: 3},
+				{First: "b", Second: 2},
+			},
+		},
+		{
+			name: "all",
+			n:    -1,
+			want: []Pair[string, int]{
+				{First: "a", Second: 3},
+				{First: "b", Second: 2},
+				{First: "c", Second: 1},
+				{First: "d", Second: 1},
+			},
+		},
+		{
+			name: "zero",
+			n:    0,
+			want: []Pair[string, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MostCommon(vs, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MostCommon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,8 +4,12 @@ go 1.19
 
 require (
 	github.com/google/go-cmp v0.5.9
+	github.com/usk81/toolkit/iterator v0.0.1
 	github.com/usk81/toolkit/testkit v0.0.1
 	golang.org/x/exp v0.0.0-20221126150942-6ab00d035af9
 )
 
-replace github.com/usk81/toolkit/testkit => ../testkit
+replace (
+	github.com/usk81/toolkit/iterator => ../iterator
+	github.com/usk81/toolkit/testkit => ../testkit
+)
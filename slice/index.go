@@ -0,0 +1,153 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOutOfRange is returned when an index is out of the bounds of a slice
+var ErrOutOfRange = errors.New("index out of range")
+
+// IndexOf returns the index of the first occurrence of v in vs, or -1 if v is not present
+func IndexOf[T comparable](vs []T, v T) int {
+	for i := range vs {
+		if vs[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of v in vs, or -1 if v is not present
+func LastIndexOf[T comparable](vs []T, v T) int {
+	for i := len(vs) - 1; i >= 0; i-- {
+		if vs[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindIndex returns the index of the first element satisfying pred, or -1 if none does
+func FindIndex[T any](vs []T, pred func(v T) bool) int {
+	for i := range vs {
+		if pred(vs[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLastIndex returns the index of the last element satisfying pred, or -1 if none does
+func FindLastIndex[T any](vs []T, pred func(v T) bool) int {
+	for i := len(vs) - 1; i >= 0; i-- {
+		if pred(vs[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Find returns the first element satisfying pred. ok is false if none does.
+func Find[T any](vs []T, pred func(v T) bool) (v T, ok bool) {
+	if i := FindIndex(vs, pred); i >= 0 {
+		return vs[i], true
+	}
+	return v, false
+}
+
+// FindLast returns the last element satisfying pred. ok is false if none does.
+func FindLast[T any](vs []T, pred func(v T) bool) (v T, ok bool) {
+	if i := FindLastIndex(vs, pred); i >= 0 {
+		return vs[i], true
+	}
+	return v, false
+}
+
+// Insert creates a slice with xs inserted at index i. i may be equal to len(vs) to append.
+func Insert[T any](vs []T, i int, xs ...T) ([]T, error) {
+	if i < 0 || i > len(vs) {
+		return nil, fmt.Errorf("%w: [%d] with length %d", ErrOutOfRange, i, len(vs))
+	}
+	rs := make([]T, 0, len(vs)+len(xs))
+	rs = append(rs, vs[:i]...)
+	rs = append(rs, xs...)
+	return append(rs, vs[i:]...), nil
+}
+
+// RemoveAt creates a slice with the element at index i removed
+func RemoveAt[T any](vs []T, i int) ([]T, error) {
+	if err := checkIndex(i, len(vs)); err != nil {
+		return nil, err
+	}
+	return RemoveRange(vs, i, i+1)
+}
+
+// RemoveRange creates a slice with the elements vs[from:to] removed
+func RemoveRange[T any](vs []T, from, to int) ([]T, error) {
+	if from < 0 || to > len(vs) || from > to {
+		return nil, fmt.Errorf("%w: [%d:%d] with length %d", ErrOutOfRange, from, to, len(vs))
+	}
+	rs := make([]T, 0, len(vs)-(to-from))
+	rs = append(rs, vs[:from]...)
+	return append(rs, vs[to:]...), nil
+}
+
+// Move creates a slice with the element at index from moved to index to
+func Move[T any](vs []T, from, to int) ([]T, error) {
+	if err := checkIndex(from, len(vs)); err != nil {
+		return nil, err
+	}
+	if err := checkIndex(to, len(vs)); err != nil {
+		return nil, err
+	}
+	rs := make([]T, len(vs))
+	copy(rs, vs)
+	v := rs[from]
+	if from < to {
+		copy(rs[from:to], rs[from+1:to+1])
+	} else {
+		copy(rs[to+1:from+1], rs[to:from])
+	}
+	rs[to] = v
+	return rs, nil
+}
+
+// Swap swaps the elements at index i and j in place
+func Swap[T any](vs []T, i, j int) error {
+	if err := checkIndex(i, len(vs)); err != nil {
+		return err
+	}
+	if err := checkIndex(j, len(vs)); err != nil {
+		return err
+	}
+	vs[i], vs[j] = vs[j], vs[i]
+	return nil
+}
+
+// Replace creates a slice with the first n occurrences of old replaced by new.
+// If n < 0, there is no limit on the number of replacements.
+func Replace[T comparable](vs []T, old, new T, n int) []T {
+	if vs == nil {
+		return nil
+	}
+	rs := make([]T, len(vs))
+	copy(rs, vs)
+	for i := range rs {
+		if n == 0 {
+			break
+		}
+		if rs[i] == old {
+			rs[i] = new
+			n--
+		}
+	}
+	return rs
+}
+
+func checkIndex(i, length int) error {
+	if i < 0 || i >= length {
+		return fmt.Errorf("%w: [%d] with length %d", ErrOutOfRange, i, length)
+	}
+	return nil
+}
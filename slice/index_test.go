@@ -0,0 +1,234 @@
+package slice
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIndexOf(t *testing.T) {
+	vs := []string{"foo", "bar", "foo"}
+
+	tests := []struct {
+		name     string
+		v        string
+		want     int
+		wantLast int
+	}{
+		{
+			name:     "exist",
+			v:        "foo",
+			want:     0,
+			wantLast: 2,
+		},
+		{
+			name:     "exist_once",
+			v:        "bar",
+			want:     1,
+			wantLast: 1,
+		},
+		{
+			name:     "not_exist",
+			v:        "fizz",
+			want:     -1,
+			wantLast: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexOf(vs, tt.v); got != tt.want {
+				t.Errorf("IndexOf() = %v, want %v", got, tt.want)
+			}
+			if got := LastIndexOf(vs, tt.v); got != tt.wantLast {
+				t.Errorf("LastIndexOf() = %v, want %v", got, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	vs := []int{1, 2, 3, 4}
+	even := func(v int) bool { return v%2 == 0 }
+	negative := func(v int) bool { return v < 0 }
+
+	if got := FindIndex(vs, even); got != 1 {
+		t.Errorf("FindIndex() = %v, want %v", got, 1)
+	}
+	if got := FindLastIndex(vs, even); got != 3 {
+		t.Errorf("FindLastIndex() = %v, want %v", got, 3)
+	}
+	if got, ok := Find(vs, even); !ok || got != 2 {
+		t.Errorf("Find() = %v, %v, want %v, true", got, ok, 2)
+	}
+	if got, ok := FindLast(vs, even); !ok || got != 4 {
+		t.Errorf("FindLast() = %v, %v, want %v, true", got, ok, 4)
+	}
+	if got, ok := Find(vs, negative); ok || got != 0 {
+		t.Errorf("Find() = %v, %v, want 0, false", got, ok)
+	}
+	if got, ok := FindLast(nil, negative); ok || got != 0 {
+		t.Errorf("FindLast() = %v, %v, want 0, false", got, ok)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		name    string
+		vs      []int
+		i       int
+		xs      []int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "head",
+			vs:   []int{3, 4},
+			i:    0,
+			xs:   []int{1, 2},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "middle",
+			vs:   []int{1, 4},
+			i:    1,
+			xs:   []int{2, 3},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "tail",
+			vs:   []int{1, 2},
+			i:    2,
+			xs:   []int{3},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "out_of_range",
+			vs:      []int{1, 2},
+			i:       3,
+			xs:      []int{3},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Insert(tt.vs, tt.i, tt.xs...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Insert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Insert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	vs := []int{1, 2, 3, 4}
+
+	if got, err := RemoveAt(vs, 1); err != nil || !reflect.DeepEqual(got, []int{1, 3, 4}) {
+		t.Errorf("RemoveAt() = %v, %v, want %v", got, err, []int{1, 3, 4})
+	}
+	if got, err := RemoveRange(vs, 1, 3); err != nil || !reflect.DeepEqual(got, []int{1, 4}) {
+		t.Errorf("RemoveRange() = %v, %v, want %v", got, err, []int{1, 4})
+	}
+	if !reflect.DeepEqual(vs, []int{1, 2, 3, 4}) {
+		t.Errorf("source slice was modified: %v", vs)
+	}
+	if _, err := RemoveAt(vs, 4); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("RemoveAt() error = %v, want %v", err, ErrOutOfRange)
+	}
+	if _, err := RemoveRange(vs, 3, 1); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("RemoveRange() error = %v, want %v", err, ErrOutOfRange)
+	}
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    int
+		to      int
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "forward",
+			from: 0,
+			to:   2,
+			want: []string{"b", "c", "a", "d"},
+		},
+		{
+			name: "backward",
+			from: 3,
+			to:   1,
+			want: []string{"a", "d", "b", "c"},
+		},
+		{
+			name: "same",
+			from: 1,
+			to:   1,
+			want: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:    "out_of_range",
+			from:    1,
+			to:      4,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Move([]string{"a", "b", "c", "d"}, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Move() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Move() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSwap(t *testing.T) {
+	vs := []int{1, 2, 3}
+	if err := Swap(vs, 0, 2); err != nil || !reflect.DeepEqual(vs, []int{3, 2, 1}) {
+		t.Errorf("Swap() = %v, %v, want %v", vs, err, []int{3, 2, 1})
+	}
+	if err := Swap(vs, -1, 2); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Swap() error = %v, want %v", err, ErrOutOfRange)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	vs := []string{"a", "b", "a", "a"}
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{
+			name: "first",
+			n:    1,
+			want: []string{"x", "b", "a", "a"},
+		},
+		{
+			name: "all",
+			n:    -1,
+			want: []string{"x", "b", "x", "x"},
+		},
+		{
+			name: "none",
+			n:    0,
+			want: []string{"a", "b", "a", "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Replace(vs, "a", "x", tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Replace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
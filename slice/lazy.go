@@ -0,0 +1,113 @@
+package slice
+
+import (
+	"errors"
+
+	"github.com/usk81/toolkit/iterator"
+)
+
+// The functions in this file are lazy counterparts of the slice helpers.
+// They return an iterator.Iterator which computes each value on demand, without intermediate slices.
+
+type (
+	chunkIterator[T any] struct {
+		vs    []T
+		size  int
+		value []T
+	}
+
+	flattenIterator[T any] struct {
+		vs    [][]T
+		outer int
+		inner int
+		value T
+	}
+)
+
+// ChunkIter is the lazy version of Chunk
+func ChunkIter[T any](vs []T, size int) (iterator.Iterator[[]T], error) {
+	if size <= 0 {
+		return nil, errors.New("size must be greater then 0")
+	}
+	return &chunkIterator[T]{vs: vs, size: size}, nil
+}
+
+// Next moves to the next chunk
+func (c *chunkIterator[T]) Next() bool {
+	if len(c.vs) == 0 {
+		c.value = nil
+		return false
+	}
+	n := c.size
+	if n > len(c.vs) {
+		n = len(c.vs)
+	}
+	c.value, c.vs = c.vs[:n:n], c.vs[n:]
+	return true
+}
+
+// Value gets the current chunk
+func (c *chunkIterator[T]) Value() []T {
+	return c.value
+}
+
+// DropIter is the lazy version of Drop
+func DropIter[T any](vs []T, dropper func(v T) bool) iterator.Iterator[T] {
+	return iterator.Filter(iterator.Slice(vs), func(v T) bool {
+		return !dropper(v)
+	})
+}
+
+// DifferenceIter is the lazy version of Difference
+func DifferenceIter[T comparable](vs, xs []T) iterator.Iterator[T] {
+	set := toSet(xs)
+	return DropIter(vs, func(v T) bool {
+		_, ok := set[v]
+		return ok
+	})
+}
+
+// IntersectionIter is the lazy version of Intersection
+func IntersectionIter[T comparable](vs, xs []T) iterator.Iterator[T] {
+	set := toSet(xs)
+	return DropIter(vs, func(v T) bool {
+		_, ok := set[v]
+		return !ok
+	})
+}
+
+// UniqueIter is the lazy version of Unique. Unlike Unique, values come in the order they were first seen.
+func UniqueIter[T comparable](xs []T) iterator.Iterator[T] {
+	seen := map[T]struct{}{}
+	return iterator.Filter(iterator.Slice(xs), func(x T) bool {
+		if _, ok := seen[x]; ok {
+			return false
+		}
+		seen[x] = struct{}{}
+		return true
+	})
+}
+
+// FlattenIter is the lazy version of Flatten
+func FlattenIter[T any](vs [][]T) iterator.Iterator[T] {
+	return &flattenIterator[T]{vs: vs}
+}
+
+// Next moves to the next element
+func (f *flattenIterator[T]) Next() bool {
+	for f.outer < len(f.vs) {
+		if f.inner < len(f.vs[f.outer]) {
+			f.value = f.vs[f.outer][f.inner]
+			f.inner++
+			return true
+		}
+		f.outer++
+		f.inner = 0
+	}
+	return false
+}
+
+// Value gets the current element
+func (f *flattenIterator[T]) Value() T {
+	return f.value
+}
@@ -0,0 +1,99 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/usk81/toolkit/iterator"
+)
+
+func TestChunkIter(t *testing.T) {
+	tests := []struct {
+		name    string
+		vs      []string
+		size    int
+		wantErr bool
+	}{
+		{name: "even", vs: []string{"a", "b", "c", "d"}, size: 2},
+		{name: "uneven", vs: []string{"a", "b", "c", "d", "e"}, size: 2},
+		{name: "larger_size", vs: []string{"a"}, size: 3},
+		{name: "empty", vs: []string{}, size: 2},
+		{name: "invalid_size", vs: []string{"a"}, size: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter, err := ChunkIter(tt.vs, tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChunkIter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want, _ := Chunk(tt.vs, tt.size)
+			if got := iterator.Collect(iter); !reflect.DeepEqual(got, want) && len(want)+len(got) > 0 {
+				t.Errorf("ChunkIter() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLazyMatchesEager(t *testing.T) {
+	vs := []int{1, 2, 2, 3, 4, 4, 5, 0}
+	xs := []int{2, 4, 6}
+	even := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{
+			name: "DropIter",
+			got:  iterator.Collect(DropIter(vs, even)),
+			want: Drop(vs, even),
+		},
+		{
+			name: "DifferenceIter",
+			got:  iterator.Collect(DifferenceIter(vs, xs)),
+			want: Difference(vs, xs),
+		},
+		{
+			name: "IntersectionIter",
+			got:  iterator.Collect(IntersectionIter(vs, xs)),
+			want: Intersection(vs, xs),
+		},
+		{
+			name: "UniqueIter",
+			got:  iterator.Collect(UniqueIter(vs)),
+			want: []int{1, 2, 3, 4, 5, 0},
+		},
+		{
+			name: "FlattenIter",
+			got:  iterator.Collect(FlattenIter([][]int{{1}, {}, nil, {2, 3}})),
+			want: Flatten([][]int{{1}, {}, nil, {2, 3}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLazyPipeline(t *testing.T) {
+	// values are pulled one at a time, so the pipeline stops as soon as the consumer does
+	calls := 0
+	iter := iterator.Map(UniqueIter(seq(0, 1000)), func(v int) int {
+		calls++
+		return v * v
+	})
+	for i := 0; i < 3 && iter.Next(); i++ {
+		iter.Value()
+	}
+	if calls != 3 {
+		t.Errorf("pipeline evaluated %d values, want 3", calls)
+	}
+}
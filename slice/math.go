@@ -0,0 +1,195 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Number is a constraint that permits any integer or floating-point type
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Sum returns the sum of all elements. Integer overflow wraps around; use SumChecked to detect it.
+func Sum[T Number](vs []T) T {
+	var sum T
+	for _, v := range vs {
+		sum += v
+	}
+	return sum
+}
+
+// SumBy returns the sum of the values f extracts from each element
+func SumBy[T any, N Number](vs []T, f func(v T) N) N {
+	var sum N
+	for _, v := range vs {
+		sum += f(v)
+	}
+	return sum
+}
+
+// SumChecked returns the sum of all elements, or an error if the sum overflows T
+func SumChecked[T constraints.Integer](vs []T) (T, error) {
+	var sum T
+	signed := ^sum < 0
+	for i, v := range vs {
+		s := sum + v
+		if (signed && ((v > 0 && s < sum) || (v < 0 && s > sum))) || (!signed && s < sum) {
+			return 0, fmt.Errorf("integer overflow at index %d", i)
+		}
+		sum = s
+	}
+	return sum, nil
+}
+
+// Product returns the product of all elements. The product of an empty slice is 1.
+func Product[T Number](vs []T) T {
+	var p T = 1
+	for _, v := range vs {
+		p *= v
+	}
+	return p
+}
+
+// Mean returns the arithmetic mean. ok is false if vs is empty.
+func Mean[T Number](vs []T) (mean float64, ok bool) {
+	if len(vs) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range vs {
+		sum += float64(v)
+	}
+	return sum / float64(len(vs)), true
+}
+
+// Median returns the middle value of the sorted elements, or the mean of the two middle values.
+// ok is false if vs is empty.
+func Median[T Number](vs []T) (median float64, ok bool) {
+	if len(vs) == 0 {
+		return 0, false
+	}
+	v, _ := Percentile(vs, 50)
+	return v, true
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100), interpolating linearly between the closest ranks
+func Percentile[T Number](vs []T, p float64) (float64, error) {
+	if len(vs) == 0 {
+		return 0, errors.New("slice must not be empty")
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, fmt.Errorf("percentile must be between 0 and 100. given: %v", p)
+	}
+	sorted := make([]T, len(vs))
+	copy(sorted, vs)
+	slices.Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	frac := rank - float64(lo)
+	return float64(sorted[lo]) + (float64(sorted[hi])-float64(sorted[lo]))*frac, nil
+}
+
+// Variance returns the population variance. ok is false if vs is empty.
+func Variance[T Number](vs []T) (variance float64, ok bool) {
+	mean, ok := Mean(vs)
+	if !ok {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range vs {
+		d := float64(v) - mean
+		sum += d * d
+	}
+	return sum / float64(len(vs)), true
+}
+
+// StdDev returns the population standard deviation. ok is false if vs is empty.
+func StdDev[T Number](vs []T) (stddev float64, ok bool) {
+	variance, ok := Variance(vs)
+	if !ok {
+		return 0, false
+	}
+	return math.Sqrt(variance), true
+}
+
+// Min returns the smallest element. ok is false if vs is empty.
+func Min[T constraints.Ordered](vs []T) (min T, ok bool) {
+	if len(vs) == 0 {
+		return min, false
+	}
+	min = vs[0]
+	for _, v := range vs[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min, true
+}
+
+// Max returns the largest element. ok is false if vs is empty.
+func Max[T constraints.Ordered](vs []T) (max T, ok bool) {
+	if len(vs) == 0 {
+		return max, false
+	}
+	max = vs[0]
+	for _, v := range vs[1:] {
+		if v > max {
+			max = v
+		}
+	}
+	return max, true
+}
+
+// MinMax returns the smallest and the largest elements in a single pass. ok is false if vs is empty.
+func MinMax[T constraints.Ordered](vs []T) (min, max T, ok bool) {
+	if len(vs) == 0 {
+		return min, max, false
+	}
+	min, max = vs[0], vs[0]
+	for _, v := range vs[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max, true
+}
+
+// MinBy returns the element for which f returns the smallest value. ok is false if vs is empty.
+func MinBy[T any, K constraints.Ordered](vs []T, f func(v T) K) (min T, ok bool) {
+	if len(vs) == 0 {
+		return min, false
+	}
+	min = vs[0]
+	mk := f(min)
+	for _, v := range vs[1:] {
+		if k := f(v); k < mk {
+			min, mk = v, k
+		}
+	}
+	return min, true
+}
+
+// MaxBy returns the element for which f returns the largest value. ok is false if vs is empty.
+func MaxBy[T any, K constraints.Ordered](vs []T, f func(v T) K) (max T, ok bool) {
+	if len(vs) == 0 {
+		return max, false
+	}
+	max = vs[0]
+	mk := f(max)
+	for _, v := range vs[1:] {
+		if k := f(v); k > mk {
+			max, mk = v, k
+		}
+	}
+	return max, true
+}
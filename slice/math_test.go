@@ -0,0 +1,182 @@
+package slice
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		vs      []int8
+		want    int8
+		wantErr bool
+	}{
+		{
+			name: "sum",
+			vs:   []int8{1, 2, 3},
+			want: 6,
+		},
+		{
+			name: "negative",
+			vs:   []int8{-100, -28},
+			want: -128,
+		},
+		{
+			name:    "overflow",
+			vs:      []int8{100, 28},
+			wantErr: true,
+		},
+		{
+			name:    "underflow",
+			vs:      []int8{-100, -29},
+			wantErr: true,
+		},
+		{
+			name: "empty",
+			vs:   []int8{},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumChecked(tt.vs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SumChecked() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SumChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := SumChecked([]uint8{200, 56}); err == nil {
+		t.Error("SumChecked() error = nil, want overflow error for unsigned integers")
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	vs := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	if got := Sum(vs); got != 40 {
+		t.Errorf("Sum() = %v, want %v", got, 40)
+	}
+	if got := Product([]float64{1.5, 2, 4}); got != 12 {
+		t.Errorf("Product() = %v, want %v", got, 12)
+	}
+	if got, ok := Mean(vs); !ok || got != 5 {
+		t.Errorf("Mean() = %v, %v, want %v, true", got, ok, 5)
+	}
+	if got, ok := Median(vs); !ok || got != 4.5 {
+		t.Errorf("Median() = %v, %v, want %v, true", got, ok, 4.5)
+	}
+	if got, ok := Median([]int{3, 1, 2}); !ok || got != 2 {
+		t.Errorf("Median() = %v, %v, want %v, true", got, ok, 2)
+	}
+	if got, ok := Variance(vs); !ok || got != 4 {
+		t.Errorf("Variance() = %v, %v, want %v, true", got, ok, 4)
+	}
+	if got, ok := StdDev(vs); !ok || got != 2 {
+		t.Errorf("StdDev() = %v, %v, want %v, true", got, ok, 2)
+	}
+
+	for _, f := range []func([]int) (float64, bool){Mean[int], Median[int], Variance[int], StdDev[int]} {
+		if _, ok := f(nil); ok {
+			t.Error("ok = true, want false for empty input")
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name    string
+		vs      []float64
+		p       float64
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "min",
+			vs:   []float64{15, 20, 35, 40, 50},
+			p:    0,
+			want: 15,
+		},
+		{
+			name: "max",
+			vs:   []float64{15, 20, 35, 40, 50},
+			p:    100,
+			want: 50,
+		},
+		{
+			name: "interpolated",
+			vs:   []float64{50, 40, 35, 20, 15},
+			p:    40,
+			want: 29,
+		},
+		{
+			name:    "out_of_range",
+			vs:      []float64{1},
+			p:       101,
+			wantErr: true,
+		},
+		{
+			name:    "nan",
+			vs:      []float64{1},
+			p:       math.NaN(),
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			vs:      nil,
+			p:       50,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Percentile(tt.vs, tt.p)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Percentile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Percentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	vs := []string{"banana", "apple", "cherry"}
+
+	if got, ok := Min(vs); !ok || got != "apple" {
+		t.Errorf("Min() = %v, %v, want apple, true", got, ok)
+	}
+	if got, ok := Max(vs); !ok || got != "cherry" {
+		t.Errorf("Max() = %v, %v, want cherry, true", got, ok)
+	}
+	if min, max, ok := MinMax(vs); !ok || min != "apple" || max != "cherry" {
+		t.Errorf("MinMax() = %v, %v, %v, want apple, cherry, true", min, max, ok)
+	}
+	if _, _, ok := MinMax([]int{}); ok {
+		t.Error("MinMax() ok = true, want false for empty input")
+	}
+
+	type item struct {
+		name  string
+		price int
+	}
+	items := []item{{"a", 300}, {"b", 100}, {"c", 200}}
+	price := func(v item) int { return v.price }
+
+	if got, ok := MinBy(items, price); !ok || got.name != "b" {
+		t.Errorf("MinBy() = %v, %v, want b, true", got, ok)
+	}
+	if got, ok := MaxBy(items, price); !ok || got.name != "a" {
+		t.Errorf("MaxBy() = %v, %v, want a, true", got, ok)
+	}
+	if got := SumBy(items, price); got != 600 {
+		t.Errorf("SumBy() = %v, want %v", got, 600)
+	}
+}
@@ -0,0 +1,207 @@
+package slice
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+type (
+	// ParallelOptions configures the parallel helpers. The zero value uses the defaults.
+	ParallelOptions struct {
+		// Workers is the number of goroutines. Defaults to runtime.GOMAXPROCS(0).
+		Workers int
+		// ChunkSize is the number of elements a worker processes at a time.
+		// Defaults to splitting the slice into four chunks per worker.
+		ChunkSize int
+	}
+
+	// PanicError is returned when a function passed to a parallel helper panics
+	PanicError struct {
+		Value interface{}
+		Stack []byte
+	}
+)
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic recovered: %v", e.Value)
+}
+
+// ParallelMap creates a slice of values by running f over every element of vs concurrently.
+// The result keeps the order of vs.
+func ParallelMap[T, R any](ctx context.Context, vs []T, f func(v T) R, opts ParallelOptions) ([]R, error) {
+	if vs == nil {
+		return nil, nil
+	}
+	rs := make([]R, len(vs))
+	err := runParallel(ctx, len(vs), opts, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			rs[i] = f(vs[i])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// ParallelFilter creates a slice of the elements for which pred returns true, evaluating pred concurrently.
+// The result keeps the order of vs.
+func ParallelFilter[T any](ctx context.Context, vs []T, pred func(v T) bool, opts ParallelOptions) ([]T, error) {
+	if vs == nil {
+		return nil, nil
+	}
+	keep := make([]bool, len(vs))
+	err := runParallel(ctx, len(vs), opts, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			keep[i] = pred(vs[i])
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	rs := []T{}
+	for i := range vs {
+		if keep[i] {
+			rs = append(rs, vs[i])
+		}
+	}
+	return rs, nil
+}
+
+// ParallelForEach runs f over every element of vs concurrently
+func ParallelForEach[T any](ctx context.Context, vs []T, f func(v T, index int), opts ParallelOptions) error {
+	return runParallel(ctx, len(vs), opts, func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			f(vs[i], i)
+		}
+	})
+}
+
+// ParallelReduce reduces vs to a single value. Each chunk is reduced concurrently and the partial results are
+// combined pairwise in order, so combine must be associative but doesn't have to be commutative.
+// It returns the zero value if vs is empty.
+func ParallelReduce[T any](ctx context.Context, vs []T, combine func(a, b T) T, opts ParallelOptions) (T, error) {
+	var zero T
+	if len(vs) == 0 {
+		return zero, nil
+	}
+	workers, size := opts.resolve(len(vs))
+	partials := make([]T, (len(vs)+size-1)/size)
+	err := runChunks(ctx, len(vs), workers, size, func(lo, hi int) {
+		acc := vs[lo]
+		for i := lo + 1; i < hi; i++ {
+			acc = combine(acc, vs[i])
+		}
+		partials[lo/size] = acc
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	for len(partials) > 1 {
+		next := make([]T, (len(partials)+1)/2)
+		err := runChunks(ctx, len(next), workers, 1, func(i, _ int) {
+			if 2*i+1 < len(partials) {
+				next[i] = combine(partials[2*i], partials[2*i+1])
+			} else {
+				next[i] = partials[2*i]
+			}
+		})
+		if err != nil {
+			return zero, err
+		}
+		partials = next
+	}
+	return partials[0], nil
+}
+
+func (o ParallelOptions) resolve(n int) (workers, size int) {
+	workers = o.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	size = o.ChunkSize
+	if size <= 0 {
+		size = (n + workers*4 - 1) / (workers * 4)
+		if size == 0 {
+			size = 1
+		}
+	}
+	return workers, size
+}
+
+func runParallel(ctx context.Context, n int, opts ParallelOptions, f func(lo, hi int)) error {
+	if n == 0 {
+		return ctx.Err()
+	}
+	workers, size := opts.resolve(n)
+	return runChunks(ctx, n, workers, size, f)
+}
+
+// runChunks splits [0, n) into chunks of size and runs f over them with the given number of workers.
+// It stops at the first panic or when ctx is done.
+func runChunks(ctx context.Context, n, workers, size int, f func(lo, hi int)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	run := func(lo int) {
+		defer func() {
+			if r := recover(); r != nil {
+				fail(&PanicError{Value: r, Stack: debug.Stack()})
+			}
+		}()
+		hi := lo + size
+		if hi > n {
+			hi = n
+		}
+		f(lo, hi)
+	}
+
+	if chunkCount := (n + size - 1) / size; workers > chunkCount {
+		workers = chunkCount
+	}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for lo := range chunks {
+				if ctx.Err() != nil {
+					continue
+				}
+				run(lo)
+			}
+		}()
+	}
+
+loop:
+	for lo := 0; lo < n; lo += size {
+		select {
+		case chunks <- lo:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(chunks)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,134 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelMap(t *testing.T) {
+	vs := make([]int, 1000)
+	want := make([]string, len(vs))
+	for i := range vs {
+		vs[i] = i
+		want[i] = strconv.Itoa(i * 2)
+	}
+
+	tests := []struct {
+		name string
+		opts ParallelOptions
+	}{
+		{
+			name: "default",
+			opts: ParallelOptions{},
+		},
+		{
+			name: "single_worker",
+			opts: ParallelOptions{Workers: 1},
+		},
+		{
+			name: "small_chunks",
+			opts: ParallelOptions{Workers: 8, ChunkSize: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParallelMap(context.Background(), vs, func(v int) string {
+				return strconv.Itoa(v * 2)
+			}, tt.opts)
+			if err != nil {
+				t.Fatalf("ParallelMap() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParallelMap() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	vs := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	got, err := ParallelFilter(context.Background(), vs, func(v int) bool {
+		return v%3 == 0
+	}, ParallelOptions{Workers: 4, ChunkSize: 2})
+	if err != nil {
+		t.Fatalf("ParallelFilter() error = %v", err)
+	}
+	if want := []int{3, 6, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelFilter() = %v, want %v", got, want)
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	vs := make([]int64, 10000)
+	for i := range vs {
+		vs[i] = int64(i)
+	}
+	var sum int64
+	err := ParallelForEach(context.Background(), vs, func(v int64, _ int) {
+		atomic.AddInt64(&sum, v)
+	}, ParallelOptions{})
+	if err != nil {
+		t.Fatalf("ParallelForEach() error = %v", err)
+	}
+	if want := Sum(vs); sum != want {
+		t.Errorf("ParallelForEach() sum = %v, want %v", sum, want)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	vs := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+	concat := func(a, b string) string { return a + b }
+
+	for _, size := range []int{0, 1, 2, 3, 5, 20} {
+		got, err := ParallelReduce(context.Background(), vs, concat, ParallelOptions{Workers: 3, ChunkSize: size})
+		if err != nil {
+			t.Fatalf("ParallelReduce() error = %v", err)
+		}
+		if want := "abcdefghijk"; got != want {
+			t.Errorf("ParallelReduce() chunk size %d = %v, want %v", size, got, want)
+		}
+	}
+
+	got, err := ParallelReduce(context.Background(), []string{}, concat, ParallelOptions{})
+	if err != nil || got != "" {
+		t.Errorf("ParallelReduce() = %v, %v, want empty string", got, err)
+	}
+}
+
+func TestParallelPanic(t *testing.T) {
+	vs := []int{1, 2, 3, 4, 5}
+	_, err := ParallelMap(context.Background(), vs, func(v int) int {
+		if v == 3 {
+			panic("boom")
+		}
+		return v
+	}, ParallelOptions{Workers: 2, ChunkSize: 1})
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("ParallelMap() error = %v, want *PanicError", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("PanicError.Value = %v, want boom", pe.Value)
+	}
+}
+
+func TestParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var called int64
+	err := ParallelForEach(ctx, []int{1, 2, 3}, func(int, int) {
+		atomic.AddInt64(&called, 1)
+	}, ParallelOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelForEach() error = %v, want %v", err, context.Canceled)
+	}
+	if called != 0 {
+		t.Errorf("ParallelForEach() called f %d times after cancellation", called)
+	}
+}
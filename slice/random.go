@@ -0,0 +1,143 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/usk81/toolkit/iterator"
+)
+
+// Shuffle randomizes the order of the elements in place using the Fisher–Yates algorithm.
+// If r is nil, the default source of math/rand is used.
+func Shuffle[T any](vs []T, r *rand.Rand) {
+	for i := len(vs) - 1; i > 0; i-- {
+		j := intn(r, i+1)
+		vs[i], vs[j] = vs[j], vs[i]
+	}
+}
+
+// Shuffled creates a shuffled copy of vs.
+// If r is nil, the default source of math/rand is used.
+func Shuffled[T any](vs []T, r *rand.Rand) []T {
+	if vs == nil {
+		return nil
+	}
+	rs := make([]T, len(vs))
+	copy(rs, vs)
+	Shuffle(rs, r)
+	return rs
+}
+
+// Sample picks n distinct elements of vs at random, without replacement.
+// If r is nil, the default source of math/rand is used.
+func Sample[T any](vs []T, n int, r *rand.Rand) ([]T, error) {
+	if n < 0 || n > len(vs) {
+		return nil, fmt.Errorf("n must be between 0 and %d. given: %d", len(vs), n)
+	}
+	// partial Fisher–Yates over the indices, so vs is left untouched
+	idx := make([]int, len(vs))
+	for i := range idx {
+		idx[i] = i
+	}
+	rs := make([]T, n)
+	for i := 0; i < n; i++ {
+		j := i + intn(r, len(idx)-i)
+		idx[i], idx[j] = idx[j], idx[i]
+		rs[i] = vs[idx[i]]
+	}
+	return rs, nil
+}
+
+// SampleWithReplacement picks n elements of vs at random. The same element can be picked more than once.
+// If r is nil, the default source of math/rand is used.
+func SampleWithReplacement[T any](vs []T, n int, r *rand.Rand) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("n must not be negative. given: %d", n)
+	}
+	if n > 0 && len(vs) == 0 {
+		return nil, errors.New("slice must not be empty")
+	}
+	rs := make([]T, n)
+	for i := range rs {
+		rs[i] = vs[intn(r, len(vs))]
+	}
+	return rs, nil
+}
+
+// SampleIterator picks up to n values from iter at random using reservoir sampling,
+// so the source doesn't have to fit in memory.
+// If r is nil, the default source of math/rand is used.
+func SampleIterator[T any](iter iterator.Iterator[T], n int, r *rand.Rand) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("n must not be negative. given: %d", n)
+	}
+	rs := make([]T, 0, n)
+	seen := 0
+	for iter.Next() {
+		seen++
+		if len(rs) < n {
+			rs = append(rs, iter.Value())
+			continue
+		}
+		if j := intn(r, seen); j < n {
+			rs[j] = iter.Value()
+		}
+	}
+	return rs, nil
+}
+
+// WeightedChoice picks an element of vs at random with a probability proportional to its weight.
+// Weights must be finite and non-negative, and their sum must be positive and finite.
+// If r is nil, the default source of math/rand is used.
+func WeightedChoice[T any](vs []T, weights []float64, r *rand.Rand) (v T, err error) {
+	if len(vs) != len(weights) {
+		return v, errors.New("argument #1 (vs) and argument #2 (weights) must have the same number of elements")
+	}
+	var total float64
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) {
+			return v, fmt.Errorf("weight must be finite. index: %d, weight: %v", i, w)
+		}
+		if w < 0 {
+			return v, fmt.Errorf("weight must not be negative. index: %d, weight: %v", i, w)
+		}
+		total += w
+	}
+	if total <= 0 {
+		return v, errors.New("sum of weights must be greater than 0")
+	}
+	if math.IsInf(total, 0) {
+		return v, errors.New("sum of weights must be finite")
+	}
+
+	x := float64n(r) * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+		if x < w {
+			return vs[i], nil
+		}
+		x -= w
+		last = i
+	}
+	// guard against floating-point rounding
+	return vs[last], nil
+}
+
+func intn(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.Intn(n)
+	}
+	return r.Intn(n)
+}
+
+func float64n(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
@@ -0,0 +1,129 @@
+package slice
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/usk81/toolkit/iterator"
+)
+
+func TestShuffle(t *testing.T) {
+	vs := seq(0, 100)
+
+	got := Shuffled(vs, rand.New(rand.NewSource(1)))
+	if !EqualUnordered(got, vs) {
+		t.Errorf("Shuffled() = %v, isn't a permutation of %v", got, vs)
+	}
+	if reflect.DeepEqual(got, vs) {
+		t.Error("Shuffled() didn't change the order")
+	}
+	if !reflect.DeepEqual(vs, seq(0, 100)) {
+		t.Error("Shuffled() modified the source slice")
+	}
+	if again := Shuffled(vs, rand.New(rand.NewSource(1))); !reflect.DeepEqual(got, again) {
+		t.Errorf("Shuffled() isn't deterministic with the same seed: %v, %v", got, again)
+	}
+
+	Shuffle(vs, rand.New(rand.NewSource(1)))
+	if !reflect.DeepEqual(vs, got) {
+		t.Errorf("Shuffle() = %v, want %v", vs, got)
+	}
+}
+
+func TestSample(t *testing.T) {
+	vs := seq(0, 10)
+
+	tests := []struct {
+		name    string
+		n       int
+		wantErr bool
+	}{
+		{name: "some", n: 3},
+		{name: "all", n: 10},
+		{name: "zero", n: 0},
+		{name: "too_many", n: 11, wantErr: true},
+		{name: "negative", n: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sample(vs, tt.n, rand.New(rand.NewSource(42)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sample() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != tt.n || HasDuplicates(got) || !IsSubset(got, vs) {
+				t.Errorf("Sample() = %v, want %d distinct elements of %v", got, tt.n, vs)
+			}
+		})
+	}
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	got, err := SampleWithReplacement([]string{"a", "b"}, 20, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("SampleWithReplacement() error = %v", err)
+	}
+	if len(got) != 20 || !HasDuplicates(got) || !IsSubset(got, []string{"a", "b"}) {
+		t.Errorf("SampleWithReplacement() = %v", got)
+	}
+	if _, err := SampleWithReplacement([]string{}, 1, nil); err == nil {
+		t.Error("SampleWithReplacement() error = nil, want error for empty input")
+	}
+}
+
+func TestSampleIterator(t *testing.T) {
+	vs := seq(0, 1000)
+
+	got, err := SampleIterator(iterator.Slice(vs), 10, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("SampleIterator() error = %v", err)
+	}
+	if len(got) != 10 || HasDuplicates(got) || !IsSubset(got, vs) {
+		t.Errorf("SampleIterator() = %v, want 10 distinct elements", got)
+	}
+
+	got, err = SampleIterator(iterator.Slice([]int{1, 2}), 10, nil)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("SampleIterator() = %v, %v, want all elements of a short source", got, err)
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	vs := []string{"never", "rare", "often"}
+	weights := []float64{0, 1, 9}
+
+	counts := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		v, err := WeightedChoice(vs, weights, r)
+		if err != nil {
+			t.Fatalf("WeightedChoice() error = %v", err)
+		}
+		counts[v]++
+	}
+	if counts["never"] != 0 {
+		t.Errorf("WeightedChoice() picked an element with zero weight %d times", counts["never"])
+	}
+	if counts["often"] < 8500 || counts["often"] > 9500 {
+		t.Errorf("WeightedChoice() picked often %d times out of 10000, want about 9000", counts["often"])
+	}
+
+	errCases := [][]float64{
+		{1, 2},
+		{1, -1, 1},
+		{0, 0, 0},
+		{1, math.NaN(), 1},
+		{1, math.Inf(1), 1},
+		{math.MaxFloat64, math.MaxFloat64, 1},
+	}
+	for _, w := range errCases {
+		if _, err := WeightedChoice(vs, w, r); err == nil {
+			t.Errorf("WeightedChoice() error = nil with weights %v", w)
+		}
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 )
 
 // Chunk creates an array of elements split into groups the length of size.
-// If array can't be split evenly, the final chunk will be the remaining elements
+// If array can't be split evenly, the final chunk will be the remaining elements.
+// Chunks share the backing array of vs, but their capacity is clipped,
+// so appending to a chunk never overwrites the next one.
 func Chunk[T any](vs []T, size int) (rs [][]T, err error) {
 	if vs == nil {
 		return
@@ -28,11 +31,67 @@ func Chunk[T any](vs []T, size int) (rs [][]T, err error) {
 		if last > len(vs) {
 			last = len(vs)
 		}
-		rs[i] = vs[i*size : last]
+		rs[i] = vs[i*size : last : last]
 	}
 	return
 }
 
+// ChunkBy splits a slice into runs of consecutive elements for which f returns the same key.
+// A new chunk starts whenever the key changes.
+func ChunkBy[T any, K comparable](vs []T, f func(v T) K) [][]T {
+	if vs == nil {
+		return nil
+	}
+	rs := [][]T{}
+	start := 0
+	var prev K
+	for i, v := range vs {
+		k := f(v)
+		if i > 0 && k != prev {
+			rs = append(rs, vs[start:i:i])
+			start = i
+		}
+		prev = k
+	}
+	if start < len(vs) {
+		rs = append(rs, vs[start:len(vs):len(vs)])
+	}
+	return rs
+}
+
+// ChunkCopy works like Chunk, but every chunk is a copy which doesn't share memory with vs.
+func ChunkCopy[T any](vs []T, size int) ([][]T, error) {
+	rs, err := Chunk(vs, size)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rs {
+		c := make([]T, len(rs[i]))
+		copy(c, rs[i])
+		rs[i] = c
+	}
+	return rs, nil
+}
+
+// ChunkWhile splits a slice between two adjacent elements for which f returns false.
+func ChunkWhile[T any](vs []T, f func(prev, next T) bool) [][]T {
+	if vs == nil {
+		return nil
+	}
+	rs := [][]T{}
+	start := 0
+	for i := 1; i < len(vs); i++ {
+		if !f(vs[i-1], vs[i]) {
+			rs = append(rs, vs[start:i:i])
+			start = i
+		}
+	}
+	if start < len(vs) {
+		rs = append(rs, vs[start:len(vs):len(vs)])
+	}
+	return rs
+}
+
 // Compact creates an slice with all zero values removed
 func Compact[T comparable](vs []T) []T {
 	var zero T
@@ -46,6 +105,15 @@ func Compact[T comparable](vs []T) []T {
 	return rs
 }
 
+// CompactFunc creates an slice with all elements removed for which isZero returns true.
+// If isZero is nil, IsZero is used, so it works with types that are not comparable.
+func CompactFunc[T any](vs []T, isZero func(v T) bool) []T {
+	if isZero == nil {
+		isZero = IsZero[T]
+	}
+	return DropFunc(vs, isZero)
+}
+
 func Difference[T comparable](vs, xs []T) []T {
 	if len(vs) == 0 || len(xs) == 0 {
 		return vs
@@ -58,6 +126,11 @@ func Difference[T comparable](vs, xs []T) []T {
 
 // Drop creates a slice excluding some elements dropped. if dropper returns true, this element is removed.
 func Drop[T comparable](vs []T, dropper func(v T) bool) []T {
+	return DropFunc(vs, dropper)
+}
+
+// DropFunc works like Drop, but accepts any element type
+func DropFunc[T any](vs []T, dropper func(v T) bool) []T {
 	result := []T{}
 	for _, v := range vs {
 		if !dropper(v) {
@@ -67,40 +140,154 @@ func Drop[T comparable](vs []T, dropper func(v T) bool) []T {
 	return result
 }
 
-// Flattens slice a single level deep
-func Flatten[T any](v interface{}) ([]T, error) {
+// DropInPlace removes the elements for which dropper returns true by reusing the backing array of vs.
+// The order of the kept elements is preserved, and the vacated tail is zeroed so it can be garbage collected.
+// vs must not be used after the call; use the returned slice instead.
+func DropInPlace[T any](vs []T, dropper func(v T) bool) []T {
+	n := 0
+	for _, v := range vs {
+		if !dropper(v) {
+			vs[n] = v
+			n++
+		}
+	}
+	var zero T
+	for i := n; i < len(vs); i++ {
+		vs[i] = zero
+	}
+	return vs[:n]
+}
+
+// DropLast creates a slice with n elements dropped from the end.
+// The result shares the backing array of vs with its capacity clipped.
+func DropLast[T any](vs []T, n int) []T {
+	if n <= 0 {
+		return vs
+	}
+	if n > len(vs) {
+		n = len(vs)
+	}
+	l := len(vs) - n
+	return vs[:l:l]
+}
+
+// DropWhile creates a slice excluding the leading elements for which pred returns true
+func DropWhile[T any](vs []T, pred func(v T) bool) []T {
+	for i, v := range vs {
+		if !pred(v) {
+			return vs[i:]
+		}
+	}
+	return vs[len(vs):]
+}
+
+// Flatten flattens a slice of slices a single level deep
+func Flatten[T any](vs [][]T) []T {
+	if vs == nil {
+		return nil
+	}
+	n := 0
+	for _, v := range vs {
+		n += len(v)
+	}
+	rs := make([]T, 0, n)
+	for _, v := range vs {
+		rs = append(rs, v...)
+	}
+	return rs
+}
+
+// FlattenDeep recursively flattens slices, arrays, pointers and []any into a slice of T.
+// The returned error names the path of the offending element, e.g. [2][0].
+func FlattenDeep[T any](v interface{}) ([]T, error) {
 	if v == nil {
 		return nil, nil
 	}
-	return doFlatten([]T{}, v)
+	return doFlattenDeep([]T{}, v, "")
 }
 
-func doFlatten[T any](ss []T, x interface{}) ([]T, error) {
+func doFlattenDeep[T any](ss []T, x interface{}, path string) ([]T, error) {
 	var err error
-	switch v := x.(type) {
-	case T:
-		ss = append(ss, v)
-	case []T:
-		ss = append(ss, v...)
-	case []interface{}:
+	// if T is an interface type, slices and pointers satisfy it too, so they are only leaves when T is concrete
+	leafIsInterface := interface{}(*new(T)) == nil
+	if !leafIsInterface {
+		switch v := x.(type) {
+		case T:
+			return append(ss, v), nil
+		case []T:
+			return append(ss, v...), nil
+		}
+	}
+	if v, ok := x.([]interface{}); ok {
 		for i := range v {
-			if ss, err = doFlatten(ss, v[i]); err != nil {
+			if ss, err = doFlattenDeep(ss, v[i], indexPath(path, i)); err != nil {
 				return nil, err
 			}
 		}
-	default:
-		rv := reflect.ValueOf(x)
-		if rv.Kind() == reflect.Slice {
-			for i := 0; i < rv.Len(); i++ {
-				if ss, err = doFlatten(ss, rv.Index(i).Interface()); err != nil {
-					return nil, err
-				}
+		return ss, nil
+	}
+
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if ss, err = doFlattenDeep(ss, rv.Index(i).Interface(), indexPath(path, i)); err != nil {
+				return nil, err
 			}
-		} else {
-			return nil, fmt.Errorf("not vaild value given. type: %v", reflect.ValueOf(x).Kind())
 		}
+		return ss, nil
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil, fmt.Errorf("nil pointer given at %s. type: %T", displayPath(path), x)
+		}
+		return doFlattenDeep(ss, rv.Elem().Interface(), path)
+	case reflect.Invalid:
+		return nil, fmt.Errorf("nil value given at %s", displayPath(path))
+	}
+	if v, ok := x.(T); ok && leafIsInterface {
+		return append(ss, v), nil
 	}
-	return ss, nil
+	var zero T
+	return nil, fmt.Errorf("not valid value given at %s. type: %T, want: %T", displayPath(path), x, zero)
+}
+
+// FlattenDepth flattens a slice or an array up to depth levels deep.
+// Elements nested deeper than depth are kept as they are.
+func FlattenDepth(v interface{}, depth int) ([]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	rv := reflect.ValueOf(v)
+	if k := rv.Kind(); k != reflect.Slice && k != reflect.Array {
+		return nil, fmt.Errorf("not valid value given. type: %v", k)
+	}
+	return doFlattenDepth([]interface{}{}, rv, depth), nil
+}
+
+func doFlattenDepth(ss []interface{}, rv reflect.Value, depth int) []interface{} {
+	for i := 0; i < rv.Len(); i++ {
+		e := rv.Index(i)
+		if e.Kind() == reflect.Interface && !e.IsNil() {
+			e = e.Elem()
+		}
+		if k := e.Kind(); depth > 0 && (k == reflect.Slice || k == reflect.Array) {
+			ss = doFlattenDepth(ss, e, depth-1)
+			continue
+		}
+		ss = append(ss, e.Interface())
+	}
+	return ss
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "root"
+	}
+	return path
 }
 
 // Exists checks if a value exists in an slice
@@ -123,6 +310,60 @@ func Intersection[T comparable](vs, xs []T) []T {
 	return Drop(vs, dropper)
 }
 
+// IsZero checks if v is the zero value of its type.
+// Common types are checked without reflection. If T is an interface type, only nil is zero.
+func IsZero[T any](v T) bool {
+	if interface{}(*new(T)) == nil {
+		// T is an interface type
+		return interface{}(v) == nil
+	}
+	switch x := interface{}(v).(type) {
+	case string:
+		return x == ""
+	case bool:
+		return !x
+	case int:
+		return x == 0
+	case int8:
+		return x == 0
+	case int16:
+		return x == 0
+	case int32:
+		return x == 0
+	case int64:
+		return x == 0
+	case uint:
+		return x == 0
+	case uint8:
+		return x == 0
+	case uint16:
+		return x == 0
+	case uint32:
+		return x == 0
+	case uint64:
+		return x == 0
+	case uintptr:
+		return x == 0
+	case float32:
+		return x == 0
+	case float64:
+		return x == 0
+	case complex64:
+		return x == 0
+	case complex128:
+		return x == 0
+	case []byte:
+		return x == nil
+	case []string:
+		return x == nil
+	case []interface{}:
+		return x == nil
+	case map[string]interface{}:
+		return x == nil
+	}
+	return reflect.ValueOf(&v).Elem().IsZero()
+}
+
 func Reduce[T, R any](vs []T, f func(acc R, v T, index int) R, initial R) R {
 	acc := initial
 	for i := range vs {
@@ -131,6 +372,49 @@ func Reduce[T, R any](vs []T, f func(acc R, v T, index int) R, initial R) R {
 	return acc
 }
 
+// SplitAt splits a slice into two at index i. The first slice holds vs[:i] and the second holds vs[i:].
+// Both slices share the backing array of vs with their capacity clipped.
+func SplitAt[T any](vs []T, i int) ([]T, []T, error) {
+	if i < 0 || i > len(vs) {
+		return nil, nil, fmt.Errorf("%w: [%d] with length %d", ErrOutOfRange, i, len(vs))
+	}
+	return vs[:i:i], vs[i:len(vs):len(vs)], nil
+}
+
+// SplitN splits a slice into n parts whose lengths differ by at most one.
+// The first len(vs)%n parts hold one extra element. If n is greater than len(vs), the trailing parts are empty.
+func SplitN[T any](vs []T, n int) ([][]T, error) {
+	if n <= 0 {
+		return nil, errors.New("n must be greater than 0")
+	}
+	if vs == nil {
+		return nil, nil
+	}
+	size, rest := len(vs)/n, len(vs)%n
+	rs := make([][]T, n)
+	start := 0
+	for i := range rs {
+		end := start + size
+		if i < rest {
+			end++
+		}
+		rs[i] = vs[start:end:end]
+		start = end
+	}
+	return rs, nil
+}
+
+// TakeWhile creates a slice of the leading elements for which pred returns true.
+// The result shares the backing array of vs with its capacity clipped.
+func TakeWhile[T any](vs []T, pred func(v T) bool) []T {
+	for i, v := range vs {
+		if !pred(v) {
+			return vs[:i:i]
+		}
+	}
+	return vs
+}
+
 // Unique Removes duplicate values from an slice
 func Unique[T comparable](xs []T) []T {
 	if xs == nil {
@@ -104,6 +104,373 @@ func TestChunk(t *testing.T) {
 	}
 }
 
+func TestChunkAliasing(t *testing.T) {
+	vs := []int{1, 2, 3, 4}
+
+	chunks, err := Chunk(vs, 2)
+	if err != nil {
+		t.Fatalf("Chunk() error = %v", err)
+	}
+	_ = append(chunks[0], 99)
+	if !reflect.DeepEqual(chunks[1], []int{3, 4}) {
+		t.Errorf("Chunk() next chunk was overwritten: %v", chunks[1])
+	}
+
+	copied, err := ChunkCopy(vs, 2)
+	if err != nil {
+		t.Fatalf("ChunkCopy() error = %v", err)
+	}
+	copied[0][0] = 99
+	if vs[0] != 1 {
+		t.Errorf("ChunkCopy() shares memory with the source: %v", vs)
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	type args struct {
+		vs []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]int
+	}{
+		{
+			name: "split_by_parity",
+			args: args{
+				vs: []int{1, 3, 2, 4, 5, 7, 6},
+			},
+			want: [][]int{
+				{1, 3},
+				{2, 4},
+				{5, 7},
+				{6},
+			},
+		},
+		{
+			name: "single_run",
+			args: args{
+				vs: []int{2, 4, 6},
+			},
+			want: [][]int{
+				{2, 4, 6},
+			},
+		},
+		{
+			name: "empty",
+			args: args{
+				vs: []int{},
+			},
+			want: [][]int{},
+		},
+		{
+			name: "nil",
+			args: args{
+				vs: nil,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ChunkBy(tt.args.vs, func(v int) bool { return v%2 == 0 })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkWhile(t *testing.T) {
+	consecutive := func(prev, next int) bool { return next == prev+1 }
+	tests := []struct {
+		name string
+		vs   []int
+		want [][]int
+	}{
+		{
+			name: "consecutive_runs",
+			vs:   []int{1, 2, 4, 9, 10, 11, 12, 15},
+			want: [][]int{
+				{1, 2},
+				{4},
+				{9, 10, 11, 12},
+				{15},
+			},
+		},
+		{
+			name: "single",
+			vs:   []int{1},
+			want: [][]int{
+				{1},
+			},
+		},
+		{
+			name: "nil",
+			vs:   nil,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChunkWhile(tt.vs, consecutive); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkWhile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		vs        []string
+		i         int
+		wantLeft  []string
+		wantRight []string
+		wantErr   bool
+	}{
+		{
+			name:      "middle",
+			vs:        []string{"a", "b", "c"},
+			i:         1,
+			wantLeft:  []string{"a"},
+			wantRight: []string{"b", "c"},
+		},
+		{
+			name:      "head",
+			vs:        []string{"a", "b"},
+			i:         0,
+			wantLeft:  []string{},
+			wantRight: []string{"a", "b"},
+		},
+		{
+			name:      "tail",
+			vs:        []string{"a", "b"},
+			i:         2,
+			wantLeft:  []string{"a", "b"},
+			wantRight: []string{},
+		},
+		{
+			name:    "out_of_range",
+			vs:      []string{"a", "b"},
+			i:       3,
+			wantErr: true,
+		},
+		{
+			name:    "negative",
+			vs:      []string{"a", "b"},
+			i:       -1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right, err := SplitAt(tt.vs, tt.i)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitAt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(left, tt.wantLeft) || !reflect.DeepEqual(right, tt.wantRight) {
+				t.Errorf("SplitAt() = %v, %v, want %v, %v", left, right, tt.wantLeft, tt.wantRight)
+			}
+		})
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	tests := []struct {
+		name    string
+		vs      []int
+		n       int
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "even",
+			vs:   []int{1, 2, 3, 4},
+			n:    2,
+			want: [][]int{
+				{1, 2},
+				{3, 4},
+			},
+		},
+		{
+			name: "uneven",
+			vs:   []int{1, 2, 3, 4, 5, 6, 7},
+			n:    3,
+			want: [][]int{
+				{1, 2, 3},
+				{4, 5},
+				{6, 7},
+			},
+		},
+		{
+			name: "more_parts_than_elements",
+			vs:   []int{1, 2},
+			n:    3,
+			want: [][]int{
+				{1},
+				{2},
+				{},
+			},
+		},
+		{
+			name:    "zero_parts",
+			vs:      []int{1, 2},
+			n:       0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SplitN(tt.vs, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitN() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	type item struct {
+		name string
+		tags []string
+	}
+	vs := []item{
+		{name: "foo"},
+		{},
+		{tags: []string{"bar"}},
+		{},
+	}
+	want := []item{
+		{name: "foo"},
+		{tags: []string{"bar"}},
+	}
+	if got := CompactFunc(vs, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("CompactFunc() = %v, want %v", got, want)
+	}
+
+	noTags := func(v item) bool { return len(v.tags) == 0 }
+	if got := CompactFunc(vs, noTags); !reflect.DeepEqual(got, want[1:]) {
+		t.Errorf("CompactFunc() = %v, want %v", got, want[1:])
+	}
+
+	anys := []interface{}{0, false, "", nil, 1}
+	if got, want := CompactFunc(anys, nil), []interface{}{0, false, "", 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompactFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	var nilErr error
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{name: "empty_string", got: IsZero(""), want: true},
+		{name: "string", got: IsZero("foo"), want: false},
+		{name: "zero_int", got: IsZero(0), want: true},
+		{name: "int", got: IsZero(1), want: false},
+		{name: "zero_float", got: IsZero(0.0), want: true},
+		{name: "false", got: IsZero(false), want: true},
+		{name: "nil_interface", got: IsZero(nilErr), want: true},
+		{name: "interface_holding_zero_int", got: IsZero[interface{}](0), want: false},
+		{name: "interface_holding_empty_string", got: IsZero[interface{}](""), want: false},
+		{name: "nil_empty_interface", got: IsZero[interface{}](nil), want: true},
+		{name: "nil_slice", got: IsZero([]int(nil)), want: true},
+		{name: "empty_slice", got: IsZero([]int{}), want: false},
+		{name: "zero_struct", got: IsZero(struct{ v []int }{}), want: true},
+		{name: "struct", got: IsZero(struct{ v []int }{v: []int{}}), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDropInPlace(t *testing.T) {
+	a, b, c := 1, 2, 3
+	vs := []*int{&a, &b, &c}
+
+	got := DropInPlace(vs, func(v *int) bool { return *v == 2 })
+	if len(got) != 2 || *got[0] != 1 || *got[1] != 3 {
+		t.Errorf("DropInPlace() = %v, want [1 3]", got)
+	}
+	if &got[0] != &vs[0] {
+		t.Error("DropInPlace() didn't reuse the backing array")
+	}
+	if vs[2] != nil {
+		t.Errorf("DropInPlace() didn't zero the tail: %v", vs[2])
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	lessThan3 := func(v int) bool { return v < 3 }
+	tests := []struct {
+		name     string
+		vs       []int
+		wantDrop []int
+		wantTake []int
+	}{
+		{
+			name:     "partial",
+			vs:       []int{1, 2, 3, 1},
+			wantDrop: []int{3, 1},
+			wantTake: []int{1, 2},
+		},
+		{
+			name:     "all",
+			vs:       []int{1, 2},
+			wantDrop: []int{},
+			wantTake: []int{1, 2},
+		},
+		{
+			name:     "none",
+			vs:       []int{3, 1},
+			wantDrop: []int{3, 1},
+			wantTake: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DropWhile(tt.vs, lessThan3); !reflect.DeepEqual(got, tt.wantDrop) {
+				t.Errorf("DropWhile() = %v, want %v", got, tt.wantDrop)
+			}
+			if got := TakeWhile(tt.vs, lessThan3); !reflect.DeepEqual(got, tt.wantTake) {
+				t.Errorf("TakeWhile() = %v, want %v", got, tt.wantTake)
+			}
+		})
+	}
+}
+
+func TestDropLast(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "drop_one", n: 1, want: []int{1, 2}},
+		{name: "drop_zero", n: 0, want: []int{1, 2, 3}},
+		{name: "drop_negative", n: -1, want: []int{1, 2, 3}},
+		{name: "drop_all", n: 5, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DropLast([]int{1, 2, 3}, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DropLast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExists(t *testing.T) {
 	type args[T comparable] struct {
 		v  T
@@ -591,6 +958,60 @@ func TestUnique(t *testing.T) {
 }
 
 func TestFlatten(t *testing.T) {
+	type args struct {
+		vs [][]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "flatten",
+			args: args{
+				vs: [][]string{
+					{
+						"foo",
+						"bar",
+					},
+					{},
+					{
+						"fizz",
+					},
+				},
+			},
+			want: []string{
+				"foo",
+				"bar",
+				"fizz",
+			},
+		},
+		{
+			name: "empty",
+			args: args{
+				vs: [][]string{},
+			},
+			want: []string{},
+		},
+		{
+			name: "nil",
+			args: args{
+				vs: nil,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Flatten(tt.args.vs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenDeep(t *testing.T) {
+	foo := "foo"
 	type args struct {
 		v interface{}
 	}
@@ -598,7 +1019,7 @@ func TestFlatten(t *testing.T) {
 		name    string
 		args    args
 		want    []string
-		wantErr bool
+		wantErr string
 	}{
 		{
 			name: "a",
@@ -614,18 +1035,224 @@ func TestFlatten(t *testing.T) {
 			want: []string{
 				"foobar",
 			},
-			wantErr: false,
+		},
+		{
+			name: "array",
+			args: args{
+				v: [2][1]string{
+					{
+						"foo",
+					},
+					{
+						"bar",
+					},
+				},
+			},
+			want: []string{
+				"foo",
+				"bar",
+			},
+		},
+		{
+			name: "pointers",
+			args: args{
+				v: []*string{
+					&foo,
+					&foo,
+				},
+			},
+			want: []string{
+				"foo",
+				"foo",
+			},
+		},
+		{
+			name: "nested_any",
+			args: args{
+				v: []interface{}{
+					"foo",
+					[]interface{}{
+						"bar",
+						[]string{
+							"fizz",
+						},
+					},
+				},
+			},
+			want: []string{
+				"foo",
+				"bar",
+				"fizz",
+			},
+		},
+		{
+			name: "invalid_type",
+			args: args{
+				v: []interface{}{
+					"foo",
+					"bar",
+					[]interface{}{
+						1,
+					},
+				},
+			},
+			wantErr: "not valid value given at [2][0]. type: int, want: string",
+		},
+		{
+			name: "nil_pointer",
+			args: args{
+				v: []*string{
+					&foo,
+					nil,
+				},
+			},
+			wantErr: "nil pointer given at [1]. type: *string",
+		},
+		{
+			name: "not_slice",
+			args: args{
+				v: 1,
+			},
+			wantErr: "not valid value given at root. type: int, want: string",
+		},
+		{
+			name: "nil",
+			args: args{
+				v: nil,
+			},
+			want: nil,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Flatten[string](tt.args.v)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Flatten() error = %v, wantErr %v", err, tt.wantErr)
+			got, err := FlattenDeep[string](tt.args.v)
+			if err != nil {
+				if err.Error() != tt.wantErr {
+					t.Errorf("FlattenDeep() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if tt.wantErr != "" {
+				t.Errorf("FlattenDeep() error = nil, wantErr %v", tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+				t.Errorf("FlattenDeep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenDeepInterface(t *testing.T) {
+	four := 4
+	got, err := FlattenDeep[interface{}]([]interface{}{1, []interface{}{2, []int{3}}, &four, "five"})
+	if err != nil {
+		t.Fatalf("FlattenDeep() error = %v", err)
+	}
+	if want := []interface{}{1, 2, 3, 4, "five"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenDeep() = %v, want %v", got, want)
+	}
+
+	if _, err := FlattenDeep[error]([]interface{}{1}); err == nil {
+		t.Error("FlattenDeep() error = nil for an element which doesn't implement T")
+	}
+}
+
+func TestFlattenDepth(t *testing.T) {
+	type args struct {
+		v     interface{}
+		depth int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name: "depth_0",
+			args: args{
+				v: []interface{}{
+					1,
+					[]interface{}{
+						2,
+						[]int{
+							3,
+						},
+					},
+				},
+				depth: 0,
+			},
+			want: []interface{}{
+				1,
+				[]interface{}{
+					2,
+					[]int{
+						3,
+					},
+				},
+			},
+		},
+		{
+			name: "depth_1",
+			args: args{
+				v: []interface{}{
+					1,
+					[]interface{}{
+						2,
+						[]int{
+							3,
+						},
+					},
+				},
+				depth: 1,
+			},
+			want: []interface{}{
+				1,
+				2,
+				[]int{
+					3,
+				},
+			},
+		},
+		{
+			name: "depth_2",
+			args: args{
+				v: []interface{}{
+					1,
+					[]interface{}{
+						2,
+						[]int{
+							3,
+						},
+					},
+				},
+				depth: 2,
+			},
+			want: []interface{}{
+				1,
+				2,
+				3,
+			},
+		},
+		{
+			name: "not_slice",
+			args: args{
+				v:     1,
+				depth: 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FlattenDepth(tt.args.v, tt.args.depth)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FlattenDepth() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) && !tt.wantErr {
+				t.Errorf("FlattenDepth() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,141 @@
+package slice
+
+import "golang.org/x/exp/constraints"
+
+// The functions in this file expect their input to be sorted in ascending order.
+// They run in linear or logarithmic time, and the result is undefined for unsorted input.
+
+// LowerBound returns the index of the first element which is not less than v, or len(vs) if there is none
+func LowerBound[T constraints.Ordered](vs []T, v T) int {
+	lo, hi := 0, len(vs)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if vs[mid] < v {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// UpperBound returns the index of the first element which is greater than v, or len(vs) if there is none
+func UpperBound[T constraints.Ordered](vs []T, v T) int {
+	lo, hi := 0, len(vs)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if vs[mid] <= v {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// InsertSorted inserts v after any equal elements, keeping vs sorted.
+// Like append, it reuses the backing array of vs if it has enough capacity.
+func InsertSorted[T constraints.Ordered](vs []T, v T) []T {
+	i := UpperBound(vs, v)
+	var zero T
+	vs = append(vs, zero)
+	copy(vs[i+1:], vs[i:])
+	vs[i] = v
+	return vs
+}
+
+// MergeSorted merges two sorted slices into a new sorted slice. Equal elements of a come before those of b.
+func MergeSorted[T constraints.Ordered](a, b []T) []T {
+	rs := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j] < a[i] {
+			rs = append(rs, b[j])
+			j++
+		} else {
+			rs = append(rs, a[i])
+			i++
+		}
+	}
+	rs = append(rs, a[i:]...)
+	return append(rs, b[j:]...)
+}
+
+// DedupSorted creates a slice with consecutive duplicates removed
+func DedupSorted[T constraints.Ordered](vs []T) []T {
+	if vs == nil {
+		return nil
+	}
+	rs := []T{}
+	for i, v := range vs {
+		if i == 0 || v != vs[i-1] {
+			rs = append(rs, v)
+		}
+	}
+	return rs
+}
+
+// UnionSorted creates a sorted slice of the distinct elements found in a or b
+func UnionSorted[T constraints.Ordered](a, b []T) []T {
+	rs := make([]T, 0, len(a)+len(b))
+	push := func(v T) {
+		if n := len(rs); n == 0 || rs[n-1] != v {
+			rs = append(rs, v)
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			push(a[i])
+			i++
+		case b[j] < a[i]:
+			push(b[j])
+			j++
+		default:
+			push(a[i])
+			i++
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		push(a[i])
+	}
+	for ; j < len(b); j++ {
+		push(b[j])
+	}
+	return rs
+}
+
+// IntersectSorted is the linear-time version of Intersection. It keeps the elements of a which exist in b.
+func IntersectSorted[T constraints.Ordered](a, b []T) []T {
+	rs := []T{}
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j < len(b) && b[j] == v {
+			rs = append(rs, v)
+		}
+	}
+	return rs
+}
+
+// DifferenceSorted is the linear-time version of Difference. It keeps the elements of a which don't exist in b.
+func DifferenceSorted[T constraints.Ordered](a, b []T) []T {
+	if len(a) == 0 || len(b) == 0 {
+		return a
+	}
+	rs := []T{}
+	j := 0
+	for _, v := range a {
+		for j < len(b) && b[j] < v {
+			j++
+		}
+		if j == len(b) || b[j] != v {
+			rs = append(rs, v)
+		}
+	}
+	return rs
+}
@@ -0,0 +1,105 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBound(t *testing.T) {
+	vs := []int{1, 3, 3, 3, 7}
+
+	tests := []struct {
+		name      string
+		v         int
+		wantLower int
+		wantUpper int
+	}{
+		{name: "before_all", v: 0, wantLower: 0, wantUpper: 0},
+		{name: "first", v: 1, wantLower: 0, wantUpper: 1},
+		{name: "duplicated", v: 3, wantLower: 1, wantUpper: 4},
+		{name: "missing", v: 5, wantLower: 4, wantUpper: 4},
+		{name: "after_all", v: 8, wantLower: 5, wantUpper: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LowerBound(vs, tt.v); got != tt.wantLower {
+				t.Errorf("LowerBound() = %v, want %v", got, tt.wantLower)
+			}
+			if got := UpperBound(vs, tt.v); got != tt.wantUpper {
+				t.Errorf("UpperBound() = %v, want %v", got, tt.wantUpper)
+			}
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	var vs []int
+	for _, v := range []int{5, 1, 4, 1, 9, 0} {
+		vs = InsertSorted(vs, v)
+	}
+	if want := []int{0, 1, 1, 4, 5, 9}; !reflect.DeepEqual(vs, want) {
+		t.Errorf("InsertSorted() = %v, want %v", vs, want)
+	}
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	a := []int{1, 2, 2, 4, 6}
+	b := []int{2, 3, 4, 4, 7}
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{
+			name: "MergeSorted",
+			got:  MergeSorted(a, b),
+			want: []int{1, 2, 2, 2, 3, 4, 4, 4, 6, 7},
+		},
+		{
+			name: "DedupSorted",
+			got:  DedupSorted(a),
+			want: []int{1, 2, 4, 6},
+		},
+		{
+			name: "UnionSorted",
+			got:  UnionSorted(a, b),
+			want: []int{1, 2, 3, 4, 6, 7},
+		},
+		{
+			name: "IntersectSorted",
+			got:  IntersectSorted(a, b),
+			want: []int{2, 2, 4},
+		},
+		{
+			name: "DifferenceSorted",
+			got:  DifferenceSorted(a, b),
+			want: []int{1, 6},
+		},
+		{
+			name: "DifferenceSorted_empty",
+			got:  DifferenceSorted(a, nil),
+			want: a,
+		},
+		{
+			name: "IntersectSorted_empty",
+			got:  IntersectSorted(nil, b),
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+
+	// the fast paths must agree with the general implementations
+	if got, want := IntersectSorted(a, b), Intersection(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("IntersectSorted() = %v, Intersection() = %v", got, want)
+	}
+	if got, want := DifferenceSorted(a, b), Difference(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("DifferenceSorted() = %v, Difference() = %v", got, want)
+	}
+}
@@ -0,0 +1,136 @@
+package slice
+
+import "fmt"
+
+// Reverse reverses the order of the elements in place
+func Reverse[T any](vs []T) {
+	for i, j := 0, len(vs)-1; i < j; i, j = i+1, j-1 {
+		vs[i], vs[j] = vs[j], vs[i]
+	}
+}
+
+// Reversed creates a copy of vs in reverse order
+func Reversed[T any](vs []T) []T {
+	if vs == nil {
+		return nil
+	}
+	rs := make([]T, len(vs))
+	for i, v := range vs {
+		rs[len(vs)-1-i] = v
+	}
+	return rs
+}
+
+// Rotate rotates the elements in place by k positions to the left. A negative k rotates to the right.
+func Rotate[T any](vs []T, k int) {
+	k = rotation(len(vs), k)
+	if k == 0 {
+		return
+	}
+	Reverse(vs[:k])
+	Reverse(vs[k:])
+	Reverse(vs)
+}
+
+// Rotated creates a copy of vs rotated by k positions to the left. A negative k rotates to the right.
+func Rotated[T any](vs []T, k int) []T {
+	if vs == nil {
+		return nil
+	}
+	k = rotation(len(vs), k)
+	rs := make([]T, 0, len(vs))
+	rs = append(rs, vs[k:]...)
+	return append(rs, vs[:k]...)
+}
+
+// Fill sets every element of vs to v in place
+func Fill[T any](vs []T, v T) {
+	for i := range vs {
+		vs[i] = v
+	}
+}
+
+// Repeat creates a slice which holds vs n times in a row
+func Repeat[T any](vs []T, n int) ([]T, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("n must not be negative. given: %d", n)
+	}
+	rs := make([]T, 0, len(vs)*n)
+	for i := 0; i < n; i++ {
+		rs = append(rs, vs...)
+	}
+	return rs, nil
+}
+
+// Interleave creates a slice by taking one element of each slice in turn.
+// Once a slice runs out, the remaining ones continue.
+func Interleave[T any](vss ...[]T) []T {
+	n, longest := 0, 0
+	for _, vs := range vss {
+		n += len(vs)
+		if len(vs) > longest {
+			longest = len(vs)
+		}
+	}
+	rs := make([]T, 0, n)
+	for i := 0; i < longest; i++ {
+		for _, vs := range vss {
+			if i < len(vs) {
+				rs = append(rs, vs[i])
+			}
+		}
+	}
+	return rs
+}
+
+// Intersperse creates a slice with sep inserted between every two adjacent elements
+func Intersperse[T any](vs []T, sep T) []T {
+	if len(vs) == 0 {
+		return vs
+	}
+	rs := make([]T, 0, len(vs)*2-1)
+	rs = append(rs, vs[0])
+	for _, v := range vs[1:] {
+		rs = append(rs, sep, v)
+	}
+	return rs
+}
+
+// Pad creates a copy of vs extended with v up to length n.
+// If vs already holds n or more elements, it is copied as is.
+func Pad[T any](vs []T, n int, v T) []T {
+	size := len(vs)
+	if n > size {
+		size = n
+	}
+	rs := make([]T, size)
+	copy(rs, vs)
+	for i := len(vs); i < size; i++ {
+		rs[i] = v
+	}
+	return rs
+}
+
+// Times creates a slice of n elements by calling f with each index
+func Times[T any](n int, f func(i int) T) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	rs := make([]T, n)
+	for i := range rs {
+		rs[i] = f(i)
+	}
+	return rs
+}
+
+// rotation normalizes k to a left rotation within [0, n)
+func rotation(n, k int) int {
+	if n == 0 {
+		return 0
+	}
+	k %= n
+	if k < 0 {
+		k += n
+	}
+	return k
+}
@@ -0,0 +1,463 @@
+package slice
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/usk81/toolkit/testkit"
+)
+
+func TestReverse(t *testing.T) {
+	type args[T any] struct {
+		vs []T
+	}
+	type testCaseForReverse[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+
+	tests := []testkit.Testable{}
+
+	// string
+	tests = append(tests, testkit.TestCase[testCaseForReverse[string]]{
+		Describe: "case_string",
+		Cases: []testCaseForReverse[string]{
+			{
+				name: "odd",
+				args: args[string]{
+					vs: []string{
+						"a",
+						"b",
+						"c",
+					},
+				},
+				want: []string{
+					"c",
+					"b",
+					"a",
+				},
+			},
+			{
+				name: "even",
+				args: args[string]{
+					vs: []string{
+						"a",
+						"b",
+					},
+				},
+				want: []string{
+					"b",
+					"a",
+				},
+			},
+			{
+				name: "empty_slice",
+				args: args[string]{
+					vs: []string{},
+				},
+				want: []string{},
+			},
+			{
+				name: "nil",
+				args: args[string]{
+					vs: nil,
+				},
+				want: nil,
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForReverse[string]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				if got := Reversed(tt.args.vs); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Reversed() = %v, want %v", got, tt.want)
+				}
+				vs := Reversed(Reversed(tt.args.vs))
+				Reverse(vs)
+				if !reflect.DeepEqual(vs, tt.want) {
+					t.Errorf("Reverse() = %v, want %v", vs, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	type args[T any] struct {
+		vs []T
+		k  int
+	}
+	type testCaseForRotate[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+
+	tests := []testkit.Testable{}
+
+	// int
+	tests = append(tests, testkit.TestCase[testCaseForRotate[int]]{
+		Describe: "case_int",
+		Cases: []testCaseForRotate[int]{
+			{
+				name: "left",
+				args: args[int]{
+					vs: []int{1, 2, 3, 4, 5},
+					k:  2,
+				},
+				want: []int{3, 4, 5, 1, 2},
+			},
+			{
+				name: "right",
+				args: args[int]{
+					vs: []int{1, 2, 3, 4, 5},
+					k:  -2,
+				},
+				want: []int{4, 5, 1, 2, 3},
+			},
+			{
+				name: "more_than_length",
+				args: args[int]{
+					vs: []int{1, 2, 3},
+					k:  4,
+				},
+				want: []int{2, 3, 1},
+			},
+			{
+				name: "zero",
+				args: args[int]{
+					vs: []int{1, 2, 3},
+					k:  0,
+				},
+				want: []int{1, 2, 3},
+			},
+			{
+				name: "empty_slice",
+				args: args[int]{
+					vs: []int{},
+					k:  1,
+				},
+				want: []int{},
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForRotate[int]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				if got := Rotated(tt.args.vs, tt.args.k); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Rotated() = %v, want %v", got, tt.want)
+				}
+				vs := Rotated(tt.args.vs, 0)
+				Rotate(vs, tt.args.k)
+				if !reflect.DeepEqual(vs, tt.want) {
+					t.Errorf("Rotate() = %v, want %v", vs, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestFill(t *testing.T) {
+	type args[T any] struct {
+		vs []T
+		v  T
+	}
+	type testCaseForFill[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+
+	tests := []testkit.Testable{}
+
+	// string
+	tests = append(tests, testkit.TestCase[testCaseForFill[string]]{
+		Describe: "case_string",
+		Cases: []testCaseForFill[string]{
+			{
+				name: "some",
+				args: args[string]{
+					vs: []string{"a", "b", "c"},
+					v:  "x",
+				},
+				want: []string{"x", "x", "x"},
+			},
+			{
+				name: "empty_slice",
+				args: args[string]{
+					vs: []string{},
+					v:  "x",
+				},
+				want: []string{},
+			},
+			{
+				name: "nil",
+				args: args[string]{
+					vs: nil,
+					v:  "x",
+				},
+				want: nil,
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForFill[string]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				Fill(tt.args.vs, tt.args.v)
+				if !reflect.DeepEqual(tt.args.vs, tt.want) {
+					t.Errorf("Fill() = %v, want %v", tt.args.vs, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	type args[T any] struct {
+		vs []T
+		n  int
+	}
+	type testCaseForRepeat[T any] struct {
+		name    string
+		args    args[T]
+		want    []T
+		wantErr bool
+	}
+
+	tests := []testkit.Testable{}
+
+	// string
+	tests = append(tests, testkit.TestCase[testCaseForRepeat[string]]{
+		Describe: "case_string",
+		Cases: []testCaseForRepeat[string]{
+			{
+				name: "twice",
+				args: args[string]{
+					vs: []string{"a", "b"},
+					n:  2,
+				},
+				want: []string{"a", "b", "a", "b"},
+			},
+			{
+				name: "zero",
+				args: args[string]{
+					vs: []string{"a", "b"},
+					n:  0,
+				},
+				want: []string{},
+			},
+			{
+				name: "negative",
+				args: args[string]{
+					vs: []string{"a", "b"},
+					n:  -1,
+				},
+				wantErr: true,
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForRepeat[string]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				got, err := Repeat(tt.args.vs, tt.args.n)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("Repeat() error = %v, wantErr %v", err, tt.wantErr)
+					return
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Repeat() = %v, want %v", got, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	type args[T any] struct {
+		vss [][]T
+	}
+	type testCaseForInterleave[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+
+	tests := []testkit.Testable{}
+
+	// int
+	tests = append(tests, testkit.TestCase[testCaseForInterleave[int]]{
+		Describe: "case_int",
+		Cases: []testCaseForInterleave[int]{
+			{
+				name: "same_length",
+				args: args[int]{
+					vss: [][]int{{1, 3}, {2, 4}},
+				},
+				want: []int{1, 2, 3, 4},
+			},
+			{
+				name: "different_length",
+				args: args[int]{
+					vss: [][]int{{1}, {2, 4, 6}, {3, 5}},
+				},
+				want: []int{1, 2, 3, 4, 5, 6},
+			},
+			{
+				name: "no_slices",
+				args: args[int]{
+					vss: nil,
+				},
+				want: []int{},
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForInterleave[int]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				if got := Interleave(tt.args.vss...); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Interleave() = %v, want %v", got, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	type args[T any] struct {
+		vs  []T
+		sep T
+	}
+	type testCaseForIntersperse[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+
+	tests := []testkit.Testable{}
+
+	// string
+	tests = append(tests, testkit.TestCase[testCaseForIntersperse[string]]{
+		Describe: "case_string",
+		Cases: []testCaseForIntersperse[string]{
+			{
+				name: "some",
+				args: args[string]{
+					vs:  []string{"a", "b", "c"},
+					sep: ",",
+				},
+				want: []string{"a", ",", "b", ",", "c"},
+			},
+			{
+				name: "single",
+				args: args[string]{
+					vs:  []string{"a"},
+					sep: ",",
+				},
+				want: []string{"a"},
+			},
+			{
+				name: "nil",
+				args: args[string]{
+					vs:  nil,
+					sep: ",",
+				},
+				want: nil,
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForIntersperse[string]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				if got := Intersperse(tt.args.vs, tt.args.sep); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Intersperse() = %v, want %v", got, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestPad(t *testing.T) {
+	type args[T any] struct {
+		vs []T
+		n  int
+		v  T
+	}
+	type testCaseForPad[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+
+	tests := []testkit.Testable{}
+
+	// int
+	tests = append(tests, testkit.TestCase[testCaseForPad[int]]{
+		Describe: "case_int",
+		Cases: []testCaseForPad[int]{
+			{
+				name: "shorter",
+				args: args[int]{
+					vs: []int{1, 2},
+					n:  4,
+					v:  9,
+				},
+				want: []int{1, 2, 9, 9},
+			},
+			{
+				name: "longer",
+				args: args[int]{
+					vs: []int{1, 2, 3},
+					n:  2,
+					v:  9,
+				},
+				want: []int{1, 2, 3},
+			},
+		},
+		Runner: func(t *testing.T, tt testCaseForPad[int]) {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				if got := Pad(tt.args.vs, tt.args.n, tt.args.v); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Pad() = %v, want %v", got, tt.want)
+				}
+			})
+		},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		tt.Test(t)
+	}
+}
+
+func TestTimes(t *testing.T) {
+	got := Times(3, strconv.Itoa)
+	if want := []string{"0", "1", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Times() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,130 @@
+package slice
+
+import "fmt"
+
+type (
+	// Pair holds two values taken from the same position of two slices
+	Pair[A, B any] struct {
+		First  A
+		Second B
+	}
+
+	// Triple holds three values taken from the same position of three slices
+	Triple[A, B, C any] struct {
+		First  A
+		Second B
+		Third  C
+	}
+
+	// ZipPolicy decides how slices of unequal length are zipped
+	ZipPolicy int
+)
+
+const (
+	// ZipStrict returns an error if the slices don't have the same number of elements
+	ZipStrict ZipPolicy = iota
+	// ZipTruncate stops at the end of the shortest slice
+	ZipTruncate
+	// ZipPad continues to the end of the longest slice, padding the shorter ones with zero values
+	ZipPad
+)
+
+// Zip pairs up the elements of a and b by their position
+func Zip[A, B any](a []A, b []B, policy ZipPolicy) ([]Pair[A, B], error) {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] {
+		return Pair[A, B]{First: x, Second: y}
+	}, policy)
+}
+
+// Zip3 groups the elements of a, b and c by their position
+func Zip3[A, B, C any](a []A, b []B, c []C, policy ZipPolicy) ([]Triple[A, B, C], error) {
+	n, err := zipLen(policy, len(a), len(b), len(c))
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]Triple[A, B, C], n)
+	for i := range rs {
+		rs[i] = Triple[A, B, C]{
+			First:  at(a, i),
+			Second: at(b, i),
+			Third:  at(c, i),
+		}
+	}
+	return rs, nil
+}
+
+// ZipWith combines the elements of a and b at the same position with f
+func ZipWith[A, B, R any](a []A, b []B, f func(x A, y B) R, policy ZipPolicy) ([]R, error) {
+	n, err := zipLen(policy, len(a), len(b))
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]R, n)
+	for i := range rs {
+		rs[i] = f(at(a, i), at(b, i))
+	}
+	return rs, nil
+}
+
+// Unzip splits a slice of pairs into two slices
+func Unzip[A, B any](ps []Pair[A, B]) ([]A, []B) {
+	if ps == nil {
+		return nil, nil
+	}
+	as := make([]A, len(ps))
+	bs := make([]B, len(ps))
+	for i, p := range ps {
+		as[i] = p.First
+		bs[i] = p.Second
+	}
+	return as, bs
+}
+
+// Unzip3 splits a slice of triples into three slices
+func Unzip3[A, B, C any](ts []Triple[A, B, C]) ([]A, []B, []C) {
+	if ts == nil {
+		return nil, nil, nil
+	}
+	as := make([]A, len(ts))
+	bs := make([]B, len(ts))
+	cs := make([]C, len(ts))
+	for i, t := range ts {
+		as[i] = t.First
+		bs[i] = t.Second
+		cs[i] = t.Third
+	}
+	return as, bs, cs
+}
+
+func zipLen(policy ZipPolicy, lens ...int) (int, error) {
+	shortest, longest := lens[0], lens[0]
+	for _, l := range lens[1:] {
+		if l < shortest {
+			shortest = l
+		}
+		if l > longest {
+			longest = l
+		}
+	}
+	switch policy {
+	case ZipStrict:
+		if shortest != longest {
+			return 0, fmt.Errorf("all arguments must have the same number of elements. lengths: %v", lens)
+		}
+		return shortest, nil
+	case ZipTruncate:
+		return shortest, nil
+	case ZipPad:
+		return longest, nil
+	}
+	return 0, fmt.Errorf("unknown zip policy: %d", policy)
+}
+
+// at returns vs[i], or the zero value if i is out of range
+func at[T any](vs []T, i int) T {
+	if i < len(vs) {
+		return vs[i]
+	}
+	var zero T
+	return zero
+}
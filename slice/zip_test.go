@@ -0,0 +1,153 @@
+package slice
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	type args struct {
+		a      []int
+		b      []string
+		policy ZipPolicy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []Pair[int, string]
+		wantErr bool
+	}{
+		{
+			name: "same_length",
+			args: args{
+				a:      []int{1, 2},
+				b:      []string{"foo", "bar"},
+				policy: ZipStrict,
+			},
+			want: []Pair[int, string]{
+				{First: 1, Second: "foo"},
+				{First: 2, Second: "bar"},
+			},
+		},
+		{
+			name: "strict",
+			args: args{
+				a:      []int{1, 2, 3},
+				b:      []string{"foo", "bar"},
+				policy: ZipStrict,
+			},
+			wantErr: true,
+		},
+		{
+			name: "truncate",
+			args: args{
+				a:      []int{1, 2, 3},
+				b:      []string{"foo", "bar"},
+				policy: ZipTruncate,
+			},
+			want: []Pair[int, string]{
+				{First: 1, Second: "foo"},
+				{First: 2, Second: "bar"},
+			},
+		},
+		{
+			name: "pad",
+			args: args{
+				a:      []int{1},
+				b:      []string{"foo", "bar"},
+				policy: ZipPad,
+			},
+			want: []Pair[int, string]{
+				{First: 1, Second: "foo"},
+				{First: 0, Second: "bar"},
+			},
+		},
+		{
+			name: "unknown_policy",
+			args: args{
+				a:      []int{1},
+				b:      []string{"foo"},
+				policy: ZipPolicy(-1),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Zip(tt.args.a, tt.args.b, tt.args.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Zip() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Zip() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	got, err := Zip3([]int{1, 2}, []string{"foo"}, []bool{true, true, true}, ZipPad)
+	if err != nil {
+		t.Fatalf("Zip3() error = %v", err)
+	}
+	want := []Triple[int, string, bool]{
+		{First: 1, Second: "foo", Third: true},
+		{First: 2, Second: "", Third: true},
+		{First: 0, Second: "", Third: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip3() = %v, want %v", got, want)
+	}
+
+	as, bs, cs := Unzip3(got)
+	if !reflect.DeepEqual(as, []int{1, 2, 0}) || !reflect.DeepEqual(bs, []string{"foo", "", ""}) || !reflect.DeepEqual(cs, []bool{true, true, true}) {
+		t.Errorf("Unzip3() = %v, %v, %v", as, bs, cs)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	got, err := ZipWith([]string{"a", "b"}, []int{1, 2, 3}, func(x string, y int) string {
+		return x + strconv.Itoa(y)
+	}, ZipTruncate)
+	if err != nil {
+		t.Fatalf("ZipWith() error = %v", err)
+	}
+	if want := []string{"a1", "b2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipWith() = %v, want %v", got, want)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	tests := []struct {
+		name  string
+		ps    []Pair[string, int]
+		wantA []string
+		wantB []int
+	}{
+		{
+			name: "unzip",
+			ps: []Pair[string, int]{
+				{First: "foo", Second: 1},
+				{First: "bar", Second: 2},
+			},
+			wantA: []string{"foo", "bar"},
+			wantB: []int{1, 2},
+		},
+		{
+			name:  "nil",
+			ps:    nil,
+			wantA: nil,
+			wantB: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Unzip(tt.ps)
+			if !reflect.DeepEqual(a, tt.wantA) || !reflect.DeepEqual(b, tt.wantB) {
+				t.Errorf("Unzip() = %v, %v, want %v, %v", a, b, tt.wantA, tt.wantB)
+			}
+		})
+	}
+}